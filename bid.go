@@ -0,0 +1,249 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec
+
+/*
+#include "go-decnumber.h"
+#include "decSingle.h"
+*/
+import "C"
+
+// BID (Binary Integer Decimal) is the alternate encoding of IEEE 754 decimals, where the coefficient
+// is stored as a binary integer instead of densely packed decimal (DPD) declets. It is used by
+// Intel's decimal library, BSON Decimal128 and many databases. The Single, Double and Quad types use
+// the DPD encoding internally; the BID encoding is only supported through the FromBID() and ToBID()
+// conversions.
+//
+// A BID encoding is canonical if its coefficient (or NaN payload) is in range and the unused bits of
+// infinities and NaNs are zero. As required by IEEE 754, an out of range coefficient is decoded as
+// zero, and an out of range NaN payload as no payload. ToBID() always returns canonical encodings.
+
+// uint128 is an unsigned 128 bits integer.
+type uint128 struct {
+	hi, lo uint64
+}
+
+// bits returns n bits of x starting at bit from (bit 0 being the least significant one). n must be
+// at most 64.
+func (x uint128) bits(from uint, n uint) uint64 {
+	var v uint64
+	if from >= 64 {
+		v = x.hi >> (from - 64)
+	} else {
+		v = x.lo>>from | x.hi<<(64-from)
+	}
+	if n < 64 {
+		v &= 1<<n - 1
+	}
+	return v
+}
+
+// low returns the n least significant bits of x.
+func (x uint128) low(n uint) uint128 {
+	if n >= 64 {
+		return uint128{x.hi & (1<<(n-64) - 1), x.lo}
+	}
+	return uint128{0, x.lo & (1<<n - 1)}
+}
+
+// or returns x | v << n.
+func (x uint128) or(v uint64, n uint) uint128 {
+	if n >= 64 {
+		x.hi |= v << (n - 64)
+	} else {
+		x.lo |= v << n
+		if n > 0 {
+			x.hi |= v >> (64 - n)
+		}
+	}
+	return x
+}
+
+// less returns x < y.
+func (x uint128) less(y uint128) bool {
+	return x.hi < y.hi || x.hi == y.hi && x.lo < y.lo
+}
+
+// div10 returns x / 10 and x % 10.
+func (x uint128) div10() (uint128, byte) {
+	w := [4]uint64{x.hi >> 32, x.hi & 0xffffffff, x.lo >> 32, x.lo & 0xffffffff}
+	var r uint64
+	for i := range w {
+		v := r<<32 | w[i]
+		w[i], r = v/10, v%10
+	}
+	return uint128{w[0]<<32 | w[1], w[2]<<32 | w[3]}, byte(r)
+}
+
+// mul10 returns x * 10 + d.
+func (x uint128) mul10(d byte) uint128 {
+	w := [4]uint64{x.hi >> 32, x.hi & 0xffffffff, x.lo >> 32, x.lo & 0xffffffff}
+	c := uint64(d)
+	for i := len(w) - 1; i >= 0; i-- {
+		v := w[i]*10 + c
+		w[i], c = v&0xffffffff, v>>32
+	}
+	return uint128{w[0]<<32 | w[1], w[2]<<32 | w[3]}
+}
+
+// bidFormat describes the BID encoding of a decimal format.
+type bidFormat struct {
+	bits   uint    // encoding length
+	ebits  uint    // length of the exponent field
+	bias   int32   // exponent bias
+	max    uint128 // maximum coefficient (10**digits - 1)
+	maxNaN uint128 // maximum NaN payload (10**(digits-1) - 1)
+}
+
+var (
+	bid32  = bidFormat{32, 8, C.DECSINGLE_Bias, uint128{0, 9999999}, uint128{0, 999999}}
+	bid64  = bidFormat{64, 10, C.DECDOUBLE_Bias, uint128{0, 9999999999999999}, uint128{0, 999999999999999}}
+	bid128 = bidFormat{128, 14, C.DECQUAD_Bias, uint128{0x1ed09bead87c0, 0x378d8e63ffffffff},
+		uint128{0x314dc6448d93, 0x38c15b09ffffffff}}
+)
+
+// decode decodes the BID encoding x into the BCD8 digits of bcd (which must have exactly the right
+// number of digits for the format), and returns the exponent (or special value) and the sign.
+func (f *bidFormat) decode(x uint128, bcd []byte) (exp int32, neg bool) {
+	var c uint128
+	neg = x.bits(f.bits-1, 1) != 0
+	switch g := x.bits(f.bits-5, 4); {
+	case g == 0xf:
+		// special: 11110 is an infinity, 11111 a NaN, and 111111 a signaling NaN
+		if x.bits(f.bits-6, 1) == 0 {
+			exp = ExponentInf
+			break
+		}
+		exp = ExponentNaN
+		if x.bits(f.bits-7, 1) != 0 {
+			exp = ExponentSNaN
+		}
+		// the payload is in the trailing significand field
+		if c = x.low(f.bits - 4 - f.ebits); f.maxNaN.less(c) {
+			c = uint128{}
+		}
+	case g>>2 == 3:
+		// 11 eeee... ccc...: the coefficient has an implicit 100 prefix
+		n := f.bits - 3 - f.ebits
+		exp = int32(x.bits(n, f.ebits)) - f.bias
+		if c = x.low(n).or(4, n); f.max.less(c) {
+			c = uint128{}
+		}
+	default:
+		n := f.bits - 1 - f.ebits
+		exp = int32(x.bits(n, f.ebits)) - f.bias
+		if c = x.low(n); f.max.less(c) {
+			c = uint128{}
+		}
+	}
+	for i := len(bcd) - 1; i >= 0; i-- {
+		c, bcd[i] = c.div10()
+	}
+	return exp, neg
+}
+
+// encode returns the canonical BID encoding of the number with the given BCD8 coefficient,
+// exponent (or special value) and sign.
+func (f *bidFormat) encode(bcd []byte, exp int32, neg bool) uint128 {
+	var x, c uint128
+	if neg {
+		x = x.or(1, f.bits-1)
+	}
+	for _, d := range bcd {
+		c = c.mul10(d)
+	}
+	switch exp {
+	case ExponentInf:
+		return x.or(0x1e, f.bits-6)
+	case ExponentSNaN:
+		x = x.or(1, f.bits-7)
+		fallthrough
+	case ExponentNaN:
+		x = x.or(0x1f, f.bits-6)
+		return uint128{x.hi | c.hi, x.lo | c.lo}
+	}
+	e := uint64(exp + f.bias)
+	if n := f.bits - 1 - f.ebits; c.less(uint128{}.or(1, n)) {
+		x = x.or(e, n)
+	} else {
+		// the coefficient starts with 100
+		n = f.bits - 3 - f.ebits
+		x = x.or(3, f.bits-3).or(e, n)
+		c = c.low(n)
+	}
+	return uint128{x.hi | c.hi, x.lo | c.lo}
+}
+
+// FromBID sets a Single from its BID encoding.
+//
+// No error is possible. Non-canonical encodings are decoded as required by IEEE 754, and the
+// result is canonical.
+//
+// Returns s.
+func (s *Single) FromBID(b uint32) *Single {
+	var bcd [SingleDigits]byte
+	exp, neg := bid32.decode(uint128{0, uint64(b)}, bcd[:])
+	C.decSingleFromBCD((*C.decSingle)(s), C.int32_t(exp), (*C.uint8_t)(&bcd[0]), decSign(neg))
+	return s
+}
+
+// ToBID returns the canonical BID encoding of a Single.
+//
+// No error is possible.
+func (s *Single) ToBID() uint32 {
+	var bcd [SingleDigits]byte
+	var exp C.int32_t
+	sign := C.decSingleToBCD((*C.decSingle)(s), &exp, (*C.uint8_t)(&bcd[0]))
+	return uint32(bid32.encode(bcd[:], int32(exp), sign != 0).lo)
+}
+
+// FromBID sets a Double from its BID encoding.
+//
+// No error is possible. Non-canonical encodings are decoded as required by IEEE 754, and the
+// result is canonical.
+//
+// Returns d.
+func (d *Double) FromBID(b uint64) *Double {
+	var bcd [DoubleDigits]byte
+	exp, neg := bid64.decode(uint128{0, b}, bcd[:])
+	C.decDoubleFromBCD((*C.decDouble)(d), C.int32_t(exp), (*C.uint8_t)(&bcd[0]), decSign(neg))
+	return d
+}
+
+// ToBID returns the canonical BID encoding of a Double.
+//
+// No error is possible.
+func (d *Double) ToBID() uint64 {
+	var bcd [DoubleDigits]byte
+	var exp C.int32_t
+	sign := C.decDoubleToBCD((*C.decDouble)(d), &exp, (*C.uint8_t)(&bcd[0]))
+	return bid64.encode(bcd[:], int32(exp), sign != 0).lo
+}
+
+// FromBID sets a Quad from its BID encoding, hi and lo being respectively its 64 most and least
+// significant bits.
+//
+// No error is possible. Non-canonical encodings are decoded as required by IEEE 754, and the
+// result is canonical.
+//
+// Returns q.
+func (q *Quad) FromBID(hi, lo uint64) *Quad {
+	var bcd [QuadDigits]byte
+	exp, neg := bid128.decode(uint128{hi, lo}, bcd[:])
+	C.decQuadFromBCD((*C.decQuad)(q), C.int32_t(exp), (*C.uint8_t)(&bcd[0]), decSign(neg))
+	return q
+}
+
+// ToBID returns the canonical BID encoding of a Quad, hi and lo being respectively its 64 most and
+// least significant bits.
+//
+// No error is possible.
+func (q *Quad) ToBID() (hi, lo uint64) {
+	var bcd [QuadDigits]byte
+	var exp C.int32_t
+	sign := C.decQuadToBCD((*C.decQuad)(q), &exp, (*C.uint8_t)(&bcd[0]))
+	x := bid128.encode(bcd[:], int32(exp), sign != 0)
+	return x.hi, x.lo
+}
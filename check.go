@@ -0,0 +1,43 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !nocheck
+
+package dec
+
+/*
+#include "go-decnumber.h"
+#include "decNumber.h"
+*/
+import "C"
+
+// check verifies that n has enough storage space to hold a result rounded to the precision of
+// ctx. If not, n is set to NaN, InsufficientStorage is set in the Context status and check returns
+// false.
+//
+// Build with the nocheck tag to disable this check.
+func (n *Number) check(ctx *Context) bool {
+	return n.checkDigits(int32(ctx.ctx.digits), ctx)
+}
+
+// checkOperand is like check, but also verifies that n has enough storage space to hold the digits
+// of lhs. This is required by the decNumber functions that copy their operand unrounded into the
+// result, like decNumberToIntegralValue() or decNumberShift().
+func (n *Number) checkOperand(lhs *Number, ctx *Context) bool {
+	digits := lhs.Digits()
+	if d := int32(ctx.ctx.digits); d > digits {
+		digits = d
+	}
+	return n.checkDigits(digits, ctx)
+}
+
+// checkDigits verifies that n has enough storage space for the requested number of digits. See
+// check().
+func (n *Number) checkDigits(digits int32, ctx *Context) bool {
+	if n.size >= digits {
+		return true
+	}
+	n.insufficientStorage(ctx)
+	return false
+}
@@ -0,0 +1,110 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !nocheck
+
+package dec_test
+
+import (
+	dec "."
+	"math"
+	"testing"
+)
+
+func TestNumber_InsufficientStorage(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitDecimal128, 0)
+		x   = dec.NewNumber(ctx.Digits()).FromString("1.5", ctx)
+		n   = dec.NewNumber(9)
+	)
+	if n.Add(x, x, ctx); !n.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+		t.Fatalf("Expected NaN with InsufficientStorage, got %s (%v)", n, ctx.Status())
+	}
+	if n.FromString("1", ctx.ZeroStatus()); !n.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+		t.Fatalf("Expected NaN with InsufficientStorage, got %s (%v)", n, ctx.Status())
+	}
+	// methods without a Context grow the storage as needed
+	n = dec.NewNumber(1)
+	if s := n.FromUint64(math.MaxUint64).String(); s != "18446744073709551615" {
+		t.Fatalf("Expected 18446744073709551615, got %s", s)
+	}
+	n = dec.NewNumber(1)
+	if s := new(dec.Quad).FromString("1.234567890123456789012345678901234", ctx).ToNumber(n).String(); s != "1.234567890123456789012345678901234" {
+		t.Fatalf("Expected 1.234567890123456789012345678901234, got %s", s)
+	}
+}
+
+func TestNumber_InsufficientStorageOperand(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitBase, 9)
+		big = dec.NewContext(dec.InitBase, 60)
+		x   = dec.NewNumber(60).FromString("123456789012345678901234567890123456789012345678901234567890", big)
+		two = dec.NewNumber(9).FromInt32(2)
+		n   = dec.NewNumber(9)
+	)
+	// ScaleB copies its operand without rounding it
+	if n.ScaleB(x, two, ctx); !n.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+		t.Fatalf("Expected NaN with InsufficientStorage, got %s (%v)", n, ctx.Status())
+	}
+	n = dec.NewNumber(60)
+	if s := n.ScaleB(x, two, ctx.ZeroStatus()).String(); s != "1.23456789012345678901234567890123456789012345678901234567890E+61" {
+		t.Fatalf("Expected 1.23456789012345678901234567890123456789012345678901234567890E+61, got %s (%v)", s, ctx.Status())
+	}
+}
+
+func TestNumber_ToIntegralStorage(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitBase, 9)
+		big = dec.NewContext(dec.InitBase, 61)
+		x   = dec.NewNumber(61)
+	)
+	for _, c := range []struct {
+		in, out string
+	}{
+		{"123456789012345678901234567890123456789012345678901234567890", "123456789012345678901234567890123456789012345678901234567890"},
+		{"12345678901234567890123456789012345678901234567890123456789.4", "12345678901234567890123456789012345678901234567890123456789"},
+	} {
+		x.FromString(c.in, big)
+		n := dec.NewNumber(9)
+		if n.ToIntegralValue(x, ctx.ZeroStatus()); !n.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+			t.Fatalf("ToIntegralValue(%s): expected NaN with InsufficientStorage, got %s (%v)", c.in, n, ctx.Status())
+		}
+		if n.ToIntegralExact(x, ctx.ZeroStatus()); !n.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+			t.Fatalf("ToIntegralExact(%s): expected NaN with InsufficientStorage, got %s (%v)", c.in, n, ctx.Status())
+		}
+		// the result is wider than the Context precision
+		n = dec.NewNumber(x.Digits())
+		if n.ToIntegralValue(x, ctx.ZeroStatus()); n.String() != c.out || ctx.ErrorStatus() != nil {
+			t.Fatalf("ToIntegralValue(%s): expected %s, got %s (%v)", c.in, c.out, n, ctx.Status())
+		}
+	}
+}
+
+func TestNumber_ShiftRotateStorage(t *testing.T) {
+	var (
+		ctx   = dec.NewContext(dec.InitBase, 9)
+		big   = dec.NewContext(dec.InitBase, 60)
+		x     = dec.NewNumber(60).FromString("123456789012345678901234567890123456789012345678901234567890", big)
+		one   = dec.NewNumber(9).FromInt32(1)
+		small = dec.NewNumber(9)
+		n     = dec.NewNumber(60)
+	)
+	for _, c := range []struct {
+		name string
+		f    func(n *dec.Number) *dec.Number
+		out  string
+	}{
+		{"Shift", func(n *dec.Number) *dec.Number { return n.Shift(x, one, ctx) }, "345678900"},
+		{"Rotate", func(n *dec.Number) *dec.Number { return n.Rotate(x, one, ctx) }, "345678902"},
+	} {
+		if c.f(small); !small.IsQNaN() || !ctx.Status().Test(dec.InsufficientStorage) {
+			t.Fatalf("%s: expected NaN with InsufficientStorage, got %s (%v)", c.name, small, ctx.Status())
+		}
+		// the operand is truncated to the Context precision
+		if s := c.f(n).String(); s != c.out {
+			t.Fatalf("%s: expected %s, got %s", c.name, c.out, s)
+		}
+		ctx.ZeroStatus()
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec
+
+/*
+#include "go-decnumber.h"
+#include "decSingle.h"
+#include "decimal32.h"
+#include "decimal64.h"
+#include "decimal128.h"
+
+// A decNumber with enough storage space for the coefficient of any decFloat.
+typedef struct {
+	decNumber dn;
+	decNumberUnit extra[(DECQUAD_Pmax+DECDPUN-1)/DECDPUN];
+} decFloatNumber;
+
+// Narrowing conversions go through decNumber so that the result is rounded only once and the
+// status is the same as with the decimalXXFromNumber functions.
+
+static decDouble *decDoubleFromQuad(decDouble *res, const decQuad *src, decContext *set) {
+	decFloatNumber n;
+	decQuadToNumber(src, &n.dn);
+	decDoubleFromNumber(res, &n.dn, set);
+	return res;
+}
+
+static decSingle *decSingleFromDouble(decSingle *res, const decDouble *src, decContext *set) {
+	decFloatNumber n;
+	decDoubleToNumber(src, &n.dn);
+	decSingleFromNumber(res, &n.dn, set);
+	return res;
+}
+
+static decSingle *decSingleFromQuad(decSingle *res, const decQuad *src, decContext *set) {
+	decFloatNumber n;
+	decQuadToNumber(src, &n.dn);
+	decSingleFromNumber(res, &n.dn, set);
+	return res;
+}
+*/
+import "C"
+
+// FromQuad narrows a Quad to a Double. Computes d = q, rounded to DoubleDigits digits.
+//
+// The Context is used only for status reporting and for the rounding mode, as with FromNumber():
+// Inexact and Rounded are set if q had to be rounded, Overflow or Underflow if its exponent is out
+// of range, and Clamped if its exponent had to be folded down to fit. A NaN payload that does not
+// fit is dropped.
+//
+// Returns d.
+func (d *Double) FromQuad(q *Quad, ctx *Context) *Double {
+	C.decDoubleFromQuad((*C.decDouble)(d), (*C.decQuad)(q), ctx.DecContext())
+	return d
+}
+
+// FromDouble narrows a Double to a Single. Computes s = d, rounded to SingleDigits digits.
+//
+// Same as Double.FromQuad().
+//
+// Returns s.
+func (s *Single) FromDouble(d *Double, ctx *Context) *Single {
+	C.decSingleFromDouble((*C.decSingle)(s), (*C.decDouble)(d), ctx.DecContext())
+	return s
+}
+
+// FromQuad narrows a Quad to a Single. Computes s = q, rounded to SingleDigits digits.
+//
+// The result is rounded only once, so this is not the same as narrowing q to a Double, then to a
+// Single. See Double.FromQuad() for the status.
+//
+// Returns s.
+func (s *Single) FromQuad(q *Quad, ctx *Context) *Single {
+	C.decSingleFromQuad((*C.decSingle)(s), (*C.decQuad)(q), ctx.DecContext())
+	return s
+}
@@ -0,0 +1,236 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec_test
+
+import (
+	dec "."
+	"encoding/hex"
+	"testing"
+)
+
+func TestConvert_Narrow(t *testing.T) {
+	var (
+		q dec.Quad
+		d dec.Double
+		s dec.Single
+	)
+	qctx := dec.NewContext(dec.InitQuad, 0)
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	for _, c := range []struct {
+		in              string
+		double, dStatus string
+		single, sStatus string
+	}{
+		{"12.99", "12.99", "No status", "12.99", "No status"},
+		{"-1.23456789012345678", "-1.234567890123457", "Multiple status", "-1.234568", "Multiple status"},
+		{"1E+384", "1.000000000000000E+384", "Clamped", "Infinity", "Multiple status"},
+		{"1E+1000", "Infinity", "Multiple status", "Infinity", "Multiple status"},
+		{"0E+1000", "0E+369", "Clamped", "0E+90", "Clamped"},
+		{"1E-400", "0E-398", "Multiple status", "0E-101", "Multiple status"},
+		{"-Infinity", "-Infinity", "No status", "-Infinity", "No status"},
+		{"NaN12", "NaN12", "No status", "NaN12", "No status"},
+		{"sNaN", "sNaN", "No status", "sNaN", "No status"},
+		// double rounding would give 1.000002
+		{"1.00000149999999999", "1.000001500000000", "Multiple status", "1.000001", "Multiple status"},
+	} {
+		q.FromString(c.in, qctx)
+		if str := d.FromQuad(&q, ctx.ZeroStatus()).String(); str != c.double || ctx.Status().String() != c.dStatus {
+			t.Fatalf("Double.FromQuad(%s): expected %s (%s), got %s (%v)", c.in, c.double, c.dStatus, str, ctx.Status())
+		}
+		if str := s.FromQuad(&q, ctx.ZeroStatus()).String(); str != c.single || ctx.Status().String() != c.sStatus {
+			t.Fatalf("Single.FromQuad(%s): expected %s (%s), got %s (%v)", c.in, c.single, c.sStatus, str, ctx.Status())
+		}
+		if str := d.ToQuad(nil).String(); str != c.double {
+			t.Fatalf("ToQuad(%s): got %s", c.double, str)
+		}
+	}
+	// going through Double rounds twice
+	d.FromString("1.000001500000000", ctx)
+	if str := s.FromDouble(&d, ctx.ZeroStatus()).String(); str != "1.000002" || !ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("FromDouble: got %s (%v)", str, ctx.Status())
+	}
+	d.FromString("9.9999999E+96", ctx)
+	if str := s.FromDouble(&d, ctx.ZeroStatus()).String(); str != "Infinity" || !ctx.Status().Test(dec.Overflow) {
+		t.Fatalf("FromDouble: got %s (%v)", str, ctx.Status())
+	}
+}
+
+// interchange is implemented by Single, Double and Quad.
+type interchange interface {
+	Bytes() []byte
+	String() string
+	IsCanonical() bool
+}
+
+func TestConvert_Bytes(t *testing.T) {
+	var (
+		q, q2 dec.Quad
+		d, d2 dec.Double
+		s, s2 dec.Single
+	)
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	// lsb returns the index of the least significant byte of an encoding
+	lsb := func(b []byte) int {
+		if dec.LittleEndian {
+			return 0
+		}
+		return len(b) - 1
+	}
+	for _, c := range []struct {
+		v         interchange
+		fromBytes func(b []byte) (interchange, error)
+		canonical func() interchange
+	}{
+		{q.FromString("-1.25E+10", ctx), func(b []byte) (interchange, error) { return q2.FromBytes(b) }, func() interchange { return q2.Canonical(&q2) }},
+		{d.FromString("-1.25E+10", ctx), func(b []byte) (interchange, error) { return d2.FromBytes(b) }, func() interchange { return d2.Canonical(&d2) }},
+		{s.FromString("-1.25E+10", ctx), func(b []byte) (interchange, error) { return s2.FromBytes(b) }, func() interchange { return s2.Canonical(&s2) }},
+	} {
+		b := c.v.Bytes()
+		if r, err := c.fromBytes(b); err != nil || r.String() != "-1.25E+10" || !r.IsCanonical() {
+			t.Fatalf("FromBytes(%x): got %s (err: %v)", b, r, err)
+		}
+		if _, err := c.fromBytes(b[1:]); err == nil {
+			t.Fatalf("FromBytes(%x): expected error", b[1:])
+		}
+		// an infinity with a non-zero coefficient continuation is not canonical
+		b[len(b)-1-lsb(b)] = 0x78
+		b[lsb(b)] = 1
+		if r, _ := c.fromBytes(b); r.IsCanonical() || r.String() != "Infinity" {
+			t.Fatalf("FromBytes(%x): got %s, IsCanonical: %v", b, r, r.IsCanonical())
+		}
+		if r := c.canonical(); !r.IsCanonical() || r.String() != "Infinity" {
+			t.Fatalf("Canonical(%x): got %s, IsCanonical: %v", b, r, r.IsCanonical())
+		}
+	}
+}
+
+func TestConvert_BigEndian(t *testing.T) {
+	var (
+		q dec.Quad
+		d dec.Double
+		s dec.Single
+	)
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	for _, c := range []struct {
+		v         string
+		x         string
+		toBytes   func() []byte
+		fromBytes func(b []byte) (interchange, error)
+	}{
+		{"1", "22080000000000000000000000000001", func() []byte { return q.FromString("1", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return q.FromBigEndianBytes(b) }},
+		{"-1.25E+10", "a20a00000000000000000000000000a5", func() []byte { return q.FromString("-1.25E+10", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return q.FromBigEndianBytes(b) }},
+		{"1", "2238000000000001", func() []byte { return d.FromString("1", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return d.FromBigEndianBytes(b) }},
+		{"7.50", "22300000000003d0", func() []byte { return d.FromString("7.50", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return d.FromBigEndianBytes(b) }},
+		{"1", "22500001", func() []byte { return s.FromString("1", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return s.FromBigEndianBytes(b) }},
+		{"-7.50", "a23003d0", func() []byte { return s.FromString("-7.50", ctx).BigEndianBytes() }, func(b []byte) (interchange, error) { return s.FromBigEndianBytes(b) }},
+	} {
+		if b := hex.EncodeToString(c.toBytes()); b != c.x {
+			t.Fatalf("BigEndianBytes(%s): expected %s, got %s", c.v, c.x, b)
+		}
+		b, _ := hex.DecodeString(c.x)
+		if r, err := c.fromBytes(b); err != nil || r.String() != c.v {
+			t.Fatalf("FromBigEndianBytes(%s): expected %s, got %s (err: %v)", c.x, c.v, r, err)
+		}
+		if _, err := c.fromBytes(b[1:]); err == nil {
+			t.Fatalf("FromBigEndianBytes(%x): expected error", b[1:])
+		}
+	}
+}
+
+func TestConvert_BID(t *testing.T) {
+	var (
+		q dec.Quad
+		d dec.Double
+		s dec.Single
+	)
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	// canonical encodings must round-trip
+	for _, c := range []struct {
+		in  string
+		bid uint32
+	}{
+		{"1", 0x32800001},
+		{"-1", 0xb2800001},
+		{"9.999999E+96", 0x77f8967f},
+		{"0E-101", 0x00000000},
+		{"1E-101", 0x00000001},
+		{"Infinity", 0x78000000},
+		{"-NaN123", 0xfc00007b},
+		{"sNaN", 0x7e000000},
+	} {
+		if b := s.FromString(c.in, ctx).ToBID(); b != c.bid {
+			t.Fatalf("Single.ToBID(%s): expected %#x, got %#x", c.in, c.bid, b)
+		}
+		if str := s.FromBID(c.bid).String(); str != c.in {
+			t.Fatalf("Single.FromBID(%#x): expected %s, got %s", c.bid, c.in, str)
+		}
+	}
+	for _, c := range []struct {
+		in  string
+		bid uint64
+	}{
+		{"1", 0x31c0000000000001},
+		{"-1", 0xb1c0000000000001},
+		{"0.1", 0x31a0000000000001},
+		{"9.999999999999999E+384", 0x77fb86f26fc0ffff},
+		{"-Infinity", 0xf800000000000000},
+		{"NaN", 0x7c00000000000000},
+		{"sNaN999999999999999", 0x7e038d7ea4c67fff},
+	} {
+		if b := d.FromString(c.in, ctx).ToBID(); b != c.bid {
+			t.Fatalf("Double.ToBID(%s): expected %#x, got %#x", c.in, c.bid, b)
+		}
+		if str := d.FromBID(c.bid).String(); str != c.in {
+			t.Fatalf("Double.FromBID(%#x): expected %s, got %s", c.bid, c.in, str)
+		}
+	}
+	for _, c := range []struct {
+		in     string
+		hi, lo uint64
+	}{
+		{"1", 0x3040000000000000, 1},
+		{"-0.001", 0xb03a000000000000, 1},
+		{"9.999999999999999999999999999999999E+6144", 0x5fffed09bead87c0, 0x378d8e63ffffffff},
+		{"1.000000000000000000000000000000000E+6144", 0x5ffe314dc6448d93, 0x38c15b0a00000000},
+		{"Infinity", 0x7800000000000000, 0},
+		{"NaN999999999999999999999999999999999", 0x7c00314dc6448d93, 0x38c15b09ffffffff},
+	} {
+		if hi, lo := q.FromString(c.in, ctx).ToBID(); hi != c.hi || lo != c.lo {
+			t.Fatalf("Quad.ToBID(%s): expected %#x %#x, got %#x %#x", c.in, c.hi, c.lo, hi, lo)
+		}
+		if str := q.FromBID(c.hi, c.lo).String(); str != c.in {
+			t.Fatalf("Quad.FromBID(%#x %#x): expected %s, got %s", c.hi, c.lo, c.in, str)
+		}
+	}
+	// non-canonical encodings
+	for _, c := range []struct {
+		bid uint32
+		out string
+	}{
+		{0x6cb89680, "0"},        // coefficient 10**7
+		{0x6ca00000, "8388608"},  // coefficient 2**23 is canonical
+		{0x780fffff, "Infinity"}, // unused bits set
+		{0x7c0f4240, "NaN"},      // payload 10**6
+	} {
+		if str := s.FromBID(c.bid).String(); str != c.out {
+			t.Fatalf("Single.FromBID(%#x): expected %s, got %s", c.bid, c.out, str)
+		}
+	}
+	if str := d.FromBID(0x6c7b86f26fc10000).String(); str != "0E+1" {
+		t.Fatalf("Double.FromBID: expected 0E+1, got %s", str)
+	}
+	for _, c := range []struct {
+		hi, lo uint64
+		out    string
+	}{
+		{0x3041ed09bead87c0, 0x378d8e6400000000, "0"}, // coefficient 10**34
+		{0x6c10000000000000, 0, "0"},                  // second form is always out of range
+		{0x7c00314dc6448d93, 0x38c15b0a00000000, "NaN"},
+	} {
+		if str := q.FromBID(c.hi, c.lo).String(); str != c.out {
+			t.Fatalf("Quad.FromBID(%#x %#x): expected %s, got %s", c.hi, c.lo, c.out, str)
+		}
+	}
+}
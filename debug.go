@@ -0,0 +1,10 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build decdebug
+
+package dec
+
+// debug enables the detection of freed or zero value Numbers. See Number.Free().
+const debug = true
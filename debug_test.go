@@ -0,0 +1,42 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build decdebug
+
+package dec_test
+
+import (
+	dec "."
+	"testing"
+)
+
+// expectPanic checks that f panics.
+func expectPanic(t *testing.T, name string, f func()) {
+	defer func() {
+		if recover() == nil {
+			t.Fatalf("%s: expected a panic", name)
+		}
+	}()
+	f()
+}
+
+func TestNumber_UseAfterFree(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitDecimal64, 0)
+		x   = dec.NewNumber(ctx.Digits()).FromInt32(42)
+		n   = dec.NewNumber(ctx.Digits())
+	)
+	n.Free()
+	n.Free()
+	expectPanic(t, "operand", func() { x.Add(x, n, ctx) })
+	expectPanic(t, "result", func() { n.Add(x, x, ctx) })
+	expectPanic(t, "result without Context", func() { n.Copy(x) })
+	expectPanic(t, "exact result", func() { n.ExactAdd(x, x, ctx) })
+	expectPanic(t, "String", func() { _ = n.String() })
+	expectPanic(t, "Quad.FromNumber", func() { new(dec.Quad).FromNumber(n, ctx) })
+	expectPanic(t, "zero value", func() { new(dec.Number).FromInt32(1) })
+	if s := x.String(); s != "42" {
+		t.Fatalf("Expected 42, got %s", s)
+	}
+}
@@ -11,6 +11,8 @@ package dec
 /*
 // #cgo flags are specified in context.go
 #include "go-decnumber.h"
+// decimal128.c defines its own DECNUMDIGITS
+#undef DECNUMDIGITS
 #include "decimal128.c"
 */
 import "C"
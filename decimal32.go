@@ -11,6 +11,8 @@ package dec
 /*
 // #cgo flags are specified in context.go
 #include "go-decnumber.h"
+// decimal32.c defines its own DECNUMDIGITS
+#undef DECNUMDIGITS
 #include "decimal32.c"
 */
 import "C"
@@ -11,6 +11,8 @@ package dec
 /*
 // #cgo flags are specified in context.go
 #include "go-decnumber.h"
+// decimal64.c defines its own DECNUMDIGITS
+#undef DECNUMDIGITS
 #include "decimal64.c"
 */
 import "C"
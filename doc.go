@@ -39,9 +39,25 @@ The decimal32, decimal64 and decimal128 types are merged into Single, Double and
 Contexts are created with a immutable precision (i.e. number of digits). If one needs to change
 precision on the fly, discard the existing context and create a new one with the required precision.
 
-From a programming standpoint, any initialized Number is a valid operand in arithmetic operations,
-regardless of the settings or existence of its creator Context (not to be confused with having a
-valid value in a given arithmetic operation).
+From a programming standpoint, any Number created by NewNumber() is a valid operand in arithmetic
+operations, regardless of the settings or existence of its creator Context (not to be confused with
+having a valid value in a given arithmetic operation). The zero value of Number has no storage space
+and must not be used.
+
+Numbers keep track of their storage space. A Number used as the result of an operation must have
+enough storage space for the precision of the Context used for that operation, otherwise its value
+is set to NaN and InsufficientStorage is set in the Context's status. This check can be disabled
+for performance critical applications by building the package with the nocheck build tag:
+
+	go build -tags nocheck
+
+The storage space of a Number can be released early with Free(). Using a Number after it has been
+freed, or a Number that was not created by NewNumber(), is detected when building the package with
+the decdebug build tag: any method called on such a Number will panic.
+
+For exact (unrounded) arithmetic, ExactAdd(), ExactSubtract() and ExactMultiply() compute the
+precision required by their operands and grow the result Number as needed, regardless of the
+Context's precision.
 
 Arithmetic functions are Number methods. The value of the receiver of the method will be set to the
 result of the operation. For example:
@@ -71,8 +87,10 @@ often enough.
 To check for errors, get the Context's status with the Status() function (see the Status
 type), or use the Context's ErrorStatus() function.
 
-The package provides facilities for managing free-lists of Numbers in order to relieve pressure on
-the garbage collector in computation intensive applications. NumberPool is in fact a simple wrapper
+Numbers are allocated on the Go heap, so creating them is cheap and their memory is accounted for
+by the Go runtime. The package also provides optional facilities for managing free-lists of Numbers
+in order to further relieve pressure on the garbage collector in computation intensive
+applications. NumberPool is in fact a simple wrapper
 around a *Context and a sync.Pool (or the lighter util.Pool provided in the util subpackage);
 NumberPool will automatically cast the return value of Get() to the desired type.
 
@@ -0,0 +1,1048 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec
+
+/*
+#include "go-decnumber.h"
+#include "decDouble.h"
+#include "decimal64.h"
+#include <stdlib.h>
+#include <string.h>
+
+// Mathematical functions, computed with decNumber.
+enum { DOUBLE_EXP, DOUBLE_LN, DOUBLE_LOG10, DOUBLE_POWER, DOUBLE_SQRT };
+
+// A decNumber with enough storage space for the coefficient of a decDouble.
+typedef struct {
+	decNumber dn;
+	decNumberUnit extra[(DECDOUBLE_Pmax+DECDPUN-1)/DECDPUN];
+} decDoubleNumber;
+
+// decDoubleMath is the decDouble version of decQuadMath.
+static decDouble *decDoubleMath(decDouble *res, const decDouble *lhs, const decDouble *rhs,
+	decContext *set, int op) {
+	decDoubleNumber l, r, n;
+	decContext wk;
+	decContextDefault(&wk, DEC_INIT_DECIMAL64);
+	wk.round = set->round;
+	decDoubleToNumber(lhs, &l.dn);
+	switch (op) {
+	case DOUBLE_EXP:
+		decNumberExp(&n.dn, &l.dn, &wk);
+		break;
+	case DOUBLE_LN:
+		decNumberLn(&n.dn, &l.dn, &wk);
+		break;
+	case DOUBLE_LOG10:
+		decNumberLog10(&n.dn, &l.dn, &wk);
+		break;
+	case DOUBLE_POWER:
+		decDoubleToNumber(rhs, &r.dn);
+		decNumberPower(&n.dn, &l.dn, &r.dn, &wk);
+		break;
+	case DOUBLE_SQRT:
+		decNumberSquareRoot(&n.dn, &l.dn, &wk);
+		break;
+	}
+	decDoubleFromNumber(res, &n.dn, &wk);
+	set->status |= wk.status & ~(DEC_Clamped|DEC_Rounded|DEC_Subnormal);
+	return res;
+}
+*/
+import "C"
+
+import (
+	"encoding/binary"
+	"math"
+	"unsafe"
+)
+
+// Double characteristics.
+const (
+	DoubleDigits = C.DECDOUBLE_Pmax
+	DoubleBytes  = C.DECDOUBLE_Bytes
+	DoubleEmax   = C.DECDOUBLE_Emax
+	DoubleEmin   = C.DECDOUBLE_Emin
+)
+
+// A Double represents a 64-bit decimal type in the IEEE 754 Standard for Floating Point Arithmetic,
+// with DoubleDigits digits of precision.
+//
+// The Double and Decimal64 structures are identical (except in name). Thus, Decimal64 specific
+// functions have been merged in Double's method set.
+//
+// Conversions to and from the Number internal format are not needed (typically the numbers are
+// represented internally in “unpacked” BCD or in a base of some other power of ten), and no memory
+// allocation is necessary, so Doubles are much faster than using Number for arithmetic
+// computations.
+type Double C.decDouble
+
+// word returns the i-th 32 bits word of d, word 0 being the most significant one, whatever the
+// byte order.
+func (d *Double) word(i int) uint32 {
+	if LittleEndian {
+		return binary.LittleEndian.Uint32(d[4-4*i:])
+	}
+	return binary.BigEndian.Uint32(d[4*i:])
+}
+
+// Bytes returns the contents of the number as a raw byte slice, in the native byte order (see
+// LittleEndian). Use BigEndianBytes() for the byte order of interchange data.
+func (d *Double) Bytes() []byte {
+	return C.GoBytes(unsafe.Pointer(d), DoubleBytes)
+}
+
+// BigEndianBytes returns the encoding of a Double in big-endian (network) byte order, whatever the
+// native byte order. This is the byte order of IEEE 754 decimal64 interchange data and of DB2
+// DECFLOAT values.
+func (d *Double) BigEndianBytes() []byte {
+	b := make([]byte, DoubleBytes)
+	copyBigEndian(b, d[:])
+	return b
+}
+
+// FromBytes sets the contents of a Double from a raw byte slice, in the same byte order as returned
+// by Bytes() (that is, the native byte order, see LittleEndian). The encoding is copied as is: use
+// IsCanonical() and Canonical() to check or fix non-canonical encodings.
+//
+// If b is not exactly DoubleBytes bytes long, d is left unchanged and a non-nil ContextError is
+// returned.
+func (d *Double) FromBytes(b []byte) (*Double, error) {
+	if len(b) != DoubleBytes {
+		return d, &ContextError{InvalidOperation}
+	}
+	copy(d[:], b)
+	return d, nil
+}
+
+// FromBigEndianBytes sets the contents of a Double from a raw byte slice in big-endian (network)
+// byte order, as returned by BigEndianBytes(). See FromBytes().
+func (d *Double) FromBigEndianBytes(b []byte) (*Double, error) {
+	if len(b) != DoubleBytes {
+		return d, &ContextError{InvalidOperation}
+	}
+	copyBigEndian(d[:], b)
+	return d, nil
+}
+
+// FromString converts a string to a Double.
+//
+// The context is supplied to this routine is used for error handling
+// (setting of status and traps) and for the rounding mode, only.
+// If an error occurs, the result will be a valid Double NaN.
+func (d *Double) FromString(s string, ctx *Context) *Double {
+	str := C.CString(s)
+	defer C.free(unsafe.Pointer(str))
+	C.decDoubleFromString((*C.decDouble)(d), str, ctx.DecContext())
+	return d
+}
+
+// String converts a Double to a string.
+//
+// No error is possible, and no status can be set.
+func (d *Double) String() string {
+	str := make([]byte, C.DECDOUBLE_String) // TODO: escapes to heap, need to check how fmt uses sync.Pool
+	pStr := (*C.char)(unsafe.Pointer(&str[0]))
+	C.decDoubleToString((*C.decDouble)(d), pStr)
+	return string(str[:C.strlen(pStr)])
+}
+
+// EngString converts a Double to a string in engineering format.
+//
+// No error is possible, and no status can be set.
+func (d *Double) EngString() string {
+	str := make([]byte, C.DECDOUBLE_String) // TODO: see sync.Pool and fmt
+	pStr := (*C.char)(unsafe.Pointer(&str[0]))
+	C.decDoubleToEngString((*C.decDouble)(d), pStr)
+	return string(str[:C.strlen(pStr)])
+}
+
+// ToNumber converts a Double to a Number.
+//
+// If n is nil, a new Number will be created with enough storage space. If n does not have enough
+// storage space, it will be reallocated.
+//
+// No error is possible.
+func (d *Double) ToNumber(n *Number) *Number {
+	if n == nil {
+		n = NewNumber(DoubleDigits)
+	} else {
+		n.grow(DoubleDigits)
+	}
+	C.decimal64ToNumber((*C.decimal64)(unsafe.Pointer(d)), n.DecNumber())
+	return n
+}
+
+// FromNumber converts a Number to a Double.
+//
+// The Context is used only for status reporting and for the rounding mode (used if the coefficient
+// is more than DoubleDigits digits or an overflow is detected). If the exponent is out of the
+// valid range then Overflow or Underflow will be raised.  After Underflow a subnormal result is
+// possible.
+//
+// Clamped is set if the number has to be 'folded down' to fit, by reducing its exponent and
+// multiplying the coefficient by a power of ten, or if the exponent on a zero had to be
+// clamped.
+//
+// returns d.
+func (d *Double) FromNumber(source *Number, ctx *Context) *Double {
+	C.decimal64FromNumber((*C.decimal64)(unsafe.Pointer(d)), source.DecNumber(), ctx.DecContext())
+	return d
+}
+
+// ToQuad widens a Double to a Quad. Computes q = d.
+//
+// If q is nil, a new Quad is created. No error is possible, and the result is canonical.
+//
+// Returns q.
+func (d *Double) ToQuad(q *Quad) *Quad {
+	if q == nil {
+		q = new(Quad)
+	}
+	C.decDoubleToWider((*C.decDouble)(d), (*C.decQuad)(q))
+	return q
+}
+
+// FromInt32 converts a signed 32 bits integer to a Double. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns d.
+func (d *Double) FromInt32(i int32) *Double {
+	C.decDoubleFromInt32((*C.decDouble)(d), C.int32_t(i))
+	return d
+}
+
+// FromUint32 converts an unsigned 32 bits integer to a Double. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns d.
+func (d *Double) FromUint32(u uint32) *Double {
+	C.decDoubleFromUInt32((*C.decDouble)(d), C.uint32_t(u))
+	return d
+}
+
+// FromInt64 converts a signed 64 bits integer to a Double.
+//
+// Unlike FromInt32(), the conversion may be inexact: if i has more than DoubleDigits digits, it is
+// rounded using the rounding mode of the Context, and Inexact is set. This is why, unlike
+// Quad.FromInt64(), it takes a Context: a 64 bits integer has up to 20 digits, which always fit in
+// a Quad but not in a Double.
+//
+// Returns d.
+func (d *Double) FromInt64(i int64, ctx *Context) *Double {
+	var q Quad
+	C.decDoubleFromWider((*C.decDouble)(d), (*C.decQuad)(q.FromInt64(i)), ctx.DecContext())
+	return d
+}
+
+// FromUint64 converts an unsigned 64 bits integer to a Double.
+//
+// Same as FromInt64().
+//
+// Returns d.
+func (d *Double) FromUint64(u uint64, ctx *Context) *Double {
+	var q Quad
+	C.decDoubleFromWider((*C.decDouble)(d), (*C.decQuad)(q.FromUint64(u)), ctx.DecContext())
+	return d
+}
+
+// ToInt32 converts a Double to a signed 32 bits integer, rounding it to an integral value if
+// necessary using the given rounding mode (the rounding mode of the Context is not used).
+//
+// If d is a NaN, an infinite, or if the rounded value is out of range, InvalidOperation is set in
+// the Context status and 0 is returned. Inexact is not set by rounding; see ToInt32Exact().
+func (d *Double) ToInt32(ctx *Context, round Rounding) int32 {
+	return int32(C.decDoubleToInt32((*C.decDouble)(d), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToInt32Exact is identical to ToInt32() except that Inexact is set in the Context status if the
+// value was rounded.
+func (d *Double) ToInt32Exact(ctx *Context, round Rounding) int32 {
+	return int32(C.decDoubleToInt32Exact((*C.decDouble)(d), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToUint32 converts a Double to an unsigned 32 bits integer.
+//
+// Same as ToInt32(). Numbers that round to a negative value other than -0 are out of range.
+func (d *Double) ToUint32(ctx *Context, round Rounding) uint32 {
+	return uint32(C.decDoubleToUInt32((*C.decDouble)(d), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToUint32Exact is identical to ToUint32() except that Inexact is set in the Context status if the
+// value was rounded.
+func (d *Double) ToUint32Exact(ctx *Context, round Rounding) uint32 {
+	return uint32(C.decDoubleToUInt32Exact((*C.decDouble)(d), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToInt64 converts a Double to a signed 64 bits integer.
+//
+// Same as ToInt32().
+func (d *Double) ToInt64(ctx *Context, round Rounding) int64 {
+	return d.toInt64(ctx, round, false)
+}
+
+// ToInt64Exact is identical to ToInt64() except that Inexact is set in the Context status if the
+// value was rounded.
+func (d *Double) ToInt64Exact(ctx *Context, round Rounding) int64 {
+	return d.toInt64(ctx, round, true)
+}
+
+// ToUint64 converts a Double to an unsigned 64 bits integer.
+//
+// Same as ToUint32().
+func (d *Double) ToUint64(ctx *Context, round Rounding) uint64 {
+	return d.toUint64Checked(ctx, round, false)
+}
+
+// ToUint64Exact is identical to ToUint64() except that Inexact is set in the Context status if the
+// value was rounded.
+func (d *Double) ToUint64Exact(ctx *Context, round Rounding) uint64 {
+	return d.toUint64Checked(ctx, round, true)
+}
+
+func (d *Double) toInt64(ctx *Context, round Rounding, exact bool) int64 {
+	if u, neg, ok := d.toUint64(ctx, round, exact); ok {
+		if !neg && u <= math.MaxInt64 {
+			return int64(u)
+		}
+		if neg && u <= -math.MinInt64 {
+			return int64(-u)
+		}
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
+func (d *Double) toUint64Checked(ctx *Context, round Rounding, exact bool) uint64 {
+	if u, neg, ok := d.toUint64(ctx, round, exact); ok && (!neg || u == 0) {
+		return u
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
+// toUint64 rounds d to an integral value and returns its magnitude and sign. ok is false if d is
+// not finite or if its magnitude does not fit in an uint64.
+func (d *Double) toUint64(ctx *Context, round Rounding, exact bool) (u uint64, neg bool, ok bool) {
+	if !d.IsFinite() {
+		return 0, false, false
+	}
+	var r Double
+	if exact {
+		// use a copy of the Context rather than changing the rounding mode of the caller's
+		set := ctx.ctx
+		set.round = uint32(round)
+		set.status = 0
+		C.decDoubleToIntegralExact((*C.decDouble)(&r), (*C.decDouble)(d), &set)
+		ctx.Status().Set(Status(set.status))
+	} else {
+		r.ToIntegralValue(d, ctx, round)
+	}
+	var bcd [DoubleDigits]byte
+	var exp C.int32_t
+	neg = C.decDoubleToBCD((*C.decDouble)(&r), &exp, (*C.uint8_t)(&bcd[0])) != 0
+	for _, digit := range bcd {
+		if u > (math.MaxUint64-uint64(digit))/10 {
+			return 0, neg, false
+		}
+		u = u*10 + uint64(digit)
+	}
+	// ToIntegralValue() leaves positive exponents alone
+	for ; exp > 0 && u != 0; exp-- {
+		if u > math.MaxUint64/10 {
+			return 0, neg, false
+		}
+		u *= 10
+	}
+	return u, neg, true
+}
+
+// Canonical copies an enoding, ensuring it is canonical.
+//
+// source may be the same as d.
+//
+// Returns d.
+//
+// No error is possible.
+func (d *Double) Canonical(source *Double) *Double {
+	C.decDoubleCanonical((*C.decDouble)(d), (*C.decDouble)(source))
+	return d
+}
+
+// IsCanonical tests wether encoding is canonical.
+func (d *Double) IsCanonical() bool {
+	return C.decDoubleIsCanonical((*C.decDouble)(d)) != 0
+}
+
+//
+// Coefficient and exponent
+//
+// The coefficient of a Double is handled as a slice of DoubleDigits BCD8 digits (one decimal digit
+// per byte, most significant first). Methods taking a coefficient as input also accept shorter
+// slices, which are padded with leading zeros.
+//
+
+// Digits returns the number of significant digits in the coefficient of a Double. If d is a NaN,
+// the number of digits in the payload is returned; if d is infinite, 1 is returned.
+func (d *Double) Digits() int32 {
+	return int32(C.decDoubleDigits((*C.decDouble)(d)))
+}
+
+// Coefficient returns the coefficient of a Double as DoubleDigits BCD8 digits, and its sign. If d
+// is a NaN, the coefficient is its payload; if d is infinite, the coefficient is all zeros.
+//
+// No error is possible.
+func (d *Double) Coefficient() (bcd []byte, neg bool) {
+	bcd = make([]byte, DoubleDigits)
+	sign := C.decDoubleGetCoefficient((*C.decDouble)(d), (*C.uint8_t)(&bcd[0]))
+	return bcd, sign != 0
+}
+
+// SetCoefficient sets the coefficient and sign of a Double, leaving its exponent or special value
+// unchanged. The most significant digit is ignored if d is a NaN, and all digits are ignored if d
+// is infinite.
+//
+// If bcd has more than DoubleDigits digits or if any of them is not in the range 0-9, d is left
+// unchanged and a non-nil ContextError is returned.
+func (d *Double) SetCoefficient(bcd []byte, neg bool) (*Double, error) {
+	var buf [DoubleDigits]byte
+	if !bcd8(buf[:], bcd) {
+		return d, &ContextError{InvalidOperation}
+	}
+	C.decDoubleSetCoefficient((*C.decDouble)(d), (*C.uint8_t)(&buf[0]), decSign(neg))
+	return d, nil
+}
+
+// Exponent returns the exponent of a Double. If d is a special value, one of ExponentInf,
+// ExponentNaN or ExponentSNaN is returned.
+//
+// No error is possible.
+func (d *Double) Exponent() int32 {
+	return int32(C.decDoubleGetExponent((*C.decDouble)(d)))
+}
+
+// SetExponent sets the exponent of a Double, leaving its coefficient and sign unchanged. exp may
+// also be one of ExponentInf, ExponentNaN or ExponentSNaN, in which case d becomes that special
+// value.
+//
+// Overflow or Underflow might be set in the Context if exp is out of range.
+//
+// Returns d.
+func (d *Double) SetExponent(exp int32, ctx *Context) *Double {
+	C.decDoubleSetExponent((*C.decDouble)(d), ctx.DecContext(), C.int32_t(exp))
+	return d
+}
+
+// SameQuantum tests whether the exponents of two Doubles are equal. It returns true if the
+// exponents of d and rhs are the same (or if both are NaN, or both are Infinite), false otherwise.
+//
+// The coefficients and signs of the operands are ignored. No error is possible.
+func (d *Double) SameQuantum(rhs *Double) bool {
+	return C.decDoubleSameQuantum((*C.decDouble)(d), (*C.decDouble)(rhs)) != 0
+}
+
+// ToBCD returns the coefficient of a Double as DoubleDigits BCD8 digits, its exponent and its sign.
+// See Coefficient() and Exponent().
+//
+// No error is possible.
+func (d *Double) ToBCD() (bcd []byte, exp int32, neg bool) {
+	bcd = make([]byte, DoubleDigits)
+	sign := C.decDoubleToBCD((*C.decDouble)(d), (*C.int32_t)(&exp), (*C.uint8_t)(&bcd[0]))
+	return bcd, exp, sign != 0
+}
+
+// FromBCD sets a Double from a BCD8 coefficient, an exponent and a sign. It is the reverse of
+// ToBCD(). exp may be one of ExponentInf, ExponentNaN or ExponentSNaN in order to build a special
+// value.
+//
+// If bcd has more than DoubleDigits digits, if any of them is not in the range 0-9, or if exp is
+// out of range, d is left unchanged and a non-nil ContextError is returned.
+func (d *Double) FromBCD(bcd []byte, exp int32, neg bool) (*Double, error) {
+	var buf [DoubleDigits]byte
+	if !bcd8(buf[:], bcd) || !doubleExponent(exp) {
+		return d, &ContextError{InvalidOperation}
+	}
+	C.decDoubleFromBCD((*C.decDouble)(d), C.int32_t(exp), (*C.uint8_t)(&buf[0]), decSign(neg))
+	return d, nil
+}
+
+// doubleExponent returns true if exp is a valid exponent for a Double or a special value.
+func doubleExponent(exp int32) bool {
+	switch exp {
+	case ExponentInf, ExponentNaN, ExponentSNaN:
+		return true
+	}
+	return exp >= DoubleEmin-DoubleDigits+1 && exp <= DoubleEmax-DoubleDigits+1
+}
+
+//
+// Comparisons and classification
+//
+// Most of the Is*() predicates have been reimplemented in Go so that they can be inlined.
+//
+
+// Class returns the Class of a Double.
+func (d *Double) Class() Class {
+	return Class(C.decDoubleClass((*C.decDouble)(d)))
+}
+
+// Compare compares two Doubles numerically. If lhs is less than rhs then d will be set to the value
+// -1. If they are equal, then d is set to 0. If lhs is greater than rhs then d will be set to the
+// value 1. If the operands are not comparable (that is, one or both is a NaN) the result will be
+// NaN.
+//
+// Returns d.
+func (d *Double) Compare(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleCompare((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// CompareSignal compares two Doubles numerically. It is identical to Compare() except that all NaNs
+// (including quiet NaNs) signal.
+//
+// Returns d.
+func (d *Double) CompareSignal(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleCompareSignal((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// CompareTotal compares two Doubles using the IEEE 754 total ordering. See Number.CompareTotal().
+//
+// No error is possible.
+//
+// Returns d.
+func (d *Double) CompareTotal(lhs *Double, rhs *Double) *Double {
+	C.decDoubleCompareTotal((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs))
+	return d
+}
+
+// CompareTotalMag compares the magnitude of two Doubles using the IEEE 754 total ordering. It is
+// identical to CompareTotal() except that the signs of the operands are ignored.
+//
+// No error is possible.
+//
+// Returns d.
+func (d *Double) CompareTotalMag(lhs *Double, rhs *Double) *Double {
+	C.decDoubleCompareTotalMag((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs))
+	return d
+}
+
+// IsFinite tests whether a Double is finite (that is, neither infinite nor a NaN).
+func (d *Double) IsFinite() bool {
+	return d.word(0)&0x78000000 != 0x78000000
+}
+
+// IsInfinite tests whether a Double is infinite.
+func (d *Double) IsInfinite() bool {
+	return d.word(0)&0x7c000000 == 0x78000000
+}
+
+// IsInteger tests whether a Double is finite and has an exponent of zero.
+func (d *Double) IsInteger() bool {
+	w := d.word(0)
+	return w&0x63fc0000 == 0x22380000 || w&0x7bfc0000 == 0x6a380000
+}
+
+// IsLogical tests whether a Double is a valid logical operand (that is, it is finite, positive,
+// has an exponent of zero and all its digits are either 0 or 1).
+func (d *Double) IsLogical() bool {
+	w := d.word(0)
+	return w&0xfbfc0000 == 0x22380000 && w&^0xfffc9124 == 0 && d.word(1)&^0x49124491 == 0
+}
+
+// IsNaN tests whether a Double is a NaN (quiet or signaling).
+func (d *Double) IsNaN() bool {
+	return d.word(0)&0x7c000000 == 0x7c000000
+}
+
+// IsNegative tests whether a Double is negative (that is, less than zero, and not a NaN).
+//
+// Note that unlike Number.IsNegative(), this does not include minus zero or NaNs with a sign of 1.
+// See IsSigned().
+func (d *Double) IsNegative() bool {
+	return d.IsSigned() && !d.IsZero() && !d.IsNaN()
+}
+
+// IsNormal tests whether a Double is normal (that is, finite, non-zero, and not subnormal).
+func (d *Double) IsNormal() bool {
+	return C.decDoubleIsNormal((*C.decDouble)(d)) != 0
+}
+
+// IsPositive tests whether a Double is positive (that is, greater than zero, and not a NaN).
+func (d *Double) IsPositive() bool {
+	return !d.IsSigned() && !d.IsZero() && !d.IsNaN()
+}
+
+// IsSignaling tests whether a Double is a signaling NaN.
+func (d *Double) IsSignaling() bool {
+	return d.word(0)&0x7e000000 == 0x7e000000
+}
+
+// IsSigned tests whether a Double has a sign of 1 (this includes minus zero and NaNs with a sign
+// of 1).
+func (d *Double) IsSigned() bool {
+	return d.word(0)&0x80000000 != 0
+}
+
+// IsSubnormal tests whether a Double is subnormal (that is, finite, non-zero, and with an adjusted
+// exponent less than the minimum exponent for Doubles).
+func (d *Double) IsSubnormal() bool {
+	return C.decDoubleIsSubnormal((*C.decDouble)(d)) != 0
+}
+
+// IsZero tests whether a Double is a zero (either positive or negative).
+func (d *Double) IsZero() bool {
+	w := d.word(0)
+	return d.word(1) == 0 && w&0x1c03ffff == 0 && w&0x60000000 != 0x60000000
+}
+
+// Zero sets the value of a Double to zero (with an exponent of 0).
+//
+// Returns d.
+func (d *Double) Zero() *Double {
+	C.decDoubleZero((*C.decDouble)(d))
+	return d
+}
+
+//
+// Arithmetic functions
+//
+// Unlike with Numbers, the precision of the Context is not used by Double methods, which always
+// round their result to DoubleDigits digits. The Context is only used for the rounding mode and
+// status reporting. Note that Double methods never set the Rounded, Subnormal and Clamped status
+// flags.
+//
+
+// Abs is the absolute value operator. Computes d = abs(lhs).
+//
+// See also CopyAbs() for a quiet bitwise version of this.
+//
+// Returns d.
+func (d *Double) Abs(lhs *Double, ctx *Context) *Double {
+	C.decDoubleAbs((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Add adds two Doubles. Computes d = lhs + rhs.
+//
+// Returns d.
+func (d *Double) Add(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleAdd((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// And is the digitwise AND operator. Computes d = lhs & rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns d.
+func (d *Double) And(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleAnd((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Copy copies a Double. Computes d = lhs.
+//
+// This is a quiet bitwise operation: no error is possible.
+//
+// Returns d.
+func (d *Double) Copy(lhs *Double) *Double {
+	C.decDoubleCopy((*C.decDouble)(d), (*C.decDouble)(lhs))
+	return d
+}
+
+// CopyAbs copies the absolute value of a Double. Computes d = abs(lhs).
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs is a signaling NaN.
+//
+// Returns d.
+func (d *Double) CopyAbs(lhs *Double) *Double {
+	C.decDoubleCopyAbs((*C.decDouble)(d), (*C.decDouble)(lhs))
+	return d
+}
+
+// CopyNegate copies a Double with its sign inverted. Computes d = -lhs.
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs is a signaling NaN.
+//
+// Returns d.
+func (d *Double) CopyNegate(lhs *Double) *Double {
+	C.decDoubleCopyNegate((*C.decDouble)(d), (*C.decDouble)(lhs))
+	return d
+}
+
+// CopySign copies a Double with the sign of another. Computes d = lhs with the sign of rhs.
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs or rhs is a signaling NaN.
+//
+// Returns d.
+func (d *Double) CopySign(lhs *Double, rhs *Double) *Double {
+	C.decDoubleCopySign((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs))
+	return d
+}
+
+// Divide divides one Double by another. Computes d = lhs / rhs.
+//
+// Returns d.
+func (d *Double) Divide(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleDivide((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// DivideInteger divides one Double by another and returns the integer part of the result.
+// Computes d = lhs / rhs, truncated to an integer with exponent 0.
+//
+// If the integer part of the result has more than DoubleDigits digits, a NaN is returned with
+// DivisionImpossible.
+//
+// Returns d.
+func (d *Double) DivideInteger(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleDivideInteger((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Exp is the exponential function. Computes d = e ** lhs (e raised to the power of lhs).
+//
+// There is no decDouble implementation of this function: lhs is converted to a Number, on the
+// stack, and the result is computed with Number.Exp() using the rounding mode of ctx, then rounded
+// to DoubleDigits digits. It will almost always be correctly rounded, but may be up to 1 ulp in
+// error in rare cases.
+//
+// Returns d.
+func (d *Double) Exp(lhs *Double, ctx *Context) *Double {
+	C.decDoubleMath((*C.decDouble)(d), (*C.decDouble)(lhs), nil, ctx.DecContext(), C.DOUBLE_EXP)
+	return d
+}
+
+// FMA is the fused multiply-add operator. Computes d = (lhs * rhs) + fhs.
+//
+// The multiplication is carried out first and is exact, so this operation has only the one,
+// final, rounding.
+//
+// Returns d.
+func (d *Double) FMA(lhs *Double, rhs *Double, fhs *Double, ctx *Context) *Double {
+	C.decDoubleFMA((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), (*C.decDouble)(fhs),
+		ctx.DecContext())
+	return d
+}
+
+// Invert is the digitwise logical inversion operator. Computes d = ~lhs: each digit of lhs is
+// inverted (a 0 digit becomes 1 and vice versa), after padding lhs with zeros on the left up to
+// DoubleDigits digits.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns d.
+func (d *Double) Invert(lhs *Double, ctx *Context) *Double {
+	C.decDoubleInvert((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Ln is the natural logarithm function. Computes d = ln(lhs).
+//
+// Like Exp(), it is computed with Number.Ln(). A NaN is returned with InvalidOperation if lhs is
+// negative.
+//
+// Returns d.
+func (d *Double) Ln(lhs *Double, ctx *Context) *Double {
+	C.decDoubleMath((*C.decDouble)(d), (*C.decDouble)(lhs), nil, ctx.DecContext(), C.DOUBLE_LN)
+	return d
+}
+
+// Log10 is the logarithm in base ten function. Computes d = log10(lhs).
+//
+// Like Exp(), it is computed with Number.Log10(). The result will be exact if lhs is an exact
+// power of ten.
+//
+// Returns d.
+func (d *Double) Log10(lhs *Double, ctx *Context) *Double {
+	C.decDoubleMath((*C.decDouble)(d), (*C.decDouble)(lhs), nil, ctx.DecContext(), C.DOUBLE_LOG10)
+	return d
+}
+
+// LogB returns the adjusted exponent of a Double, according to IEEE 754 rules. That is, the
+// exponent returned is calculated as if the decimal point followed the first significant digit (so,
+// for example, if lhs were 250 then d would be 2).
+//
+// If lhs is infinite, d is set to +Infinity. If lhs is a zero, d is set to -Infinity and
+// DivisionByZero is set. If lhs is a NaN, it is handled as usual.
+//
+// Returns d.
+func (d *Double) LogB(lhs *Double, ctx *Context) *Double {
+	C.decDoubleLogB((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Max compares two Doubles numerically and sets d to the larger. Computes d = max(lhs, rhs).
+//
+// If one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns d.
+func (d *Double) Max(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMax((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// MaxMag compares the magnitude of two Doubles numerically and sets d to the larger. It is
+// identical to Max() except that the signs of the operands are ignored.
+//
+// Returns d.
+func (d *Double) MaxMag(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMaxMag((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Min compares two Doubles numerically and sets d to the smaller. Computes d = min(lhs, rhs).
+//
+// If one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns d.
+func (d *Double) Min(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMin((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// MinMag compares the magnitude of two Doubles numerically and sets d to the smaller. It is
+// identical to Min() except that the signs of the operands are ignored.
+//
+// Returns d.
+func (d *Double) MinMag(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMinMag((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Minus is the prefix minus operator. Computes d = 0 - lhs.
+//
+// See also CopyNegate() for a quiet bitwise version of this.
+//
+// Returns d.
+func (d *Double) Minus(lhs *Double, ctx *Context) *Double {
+	C.decDoubleMinus((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Multiply multiplies one Double by another. Computes d = lhs * rhs.
+//
+// Returns d.
+func (d *Double) Multiply(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMultiply((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// NextMinus returns the next representable Double in the direction of -Infinity. Computes d = the
+// closest value to lhs that is less than lhs.
+//
+// This is computed as though by subtracting an infinitesimal amount from lhs using RoundFloor,
+// except that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns d.
+func (d *Double) NextMinus(lhs *Double, ctx *Context) *Double {
+	C.decDoubleNextMinus((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// NextPlus returns the next representable Double in the direction of +Infinity. Computes d = the
+// closest value to lhs that is greater than lhs.
+//
+// This is computed as though by adding an infinitesimal amount to lhs using RoundCeiling, except
+// that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns d.
+func (d *Double) NextPlus(lhs *Double, ctx *Context) *Double {
+	C.decDoubleNextPlus((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// NextToward returns the next representable Double from lhs in the direction of rhs. If rhs
+// compares equal to lhs, d is set to lhs with the sign of rhs.
+//
+// This is computed as though by adding or subtracting an infinitesimal amount to lhs. Unlike
+// NextPlus() and NextMinus(), Overflow, Underflow and Inexact are set as required by IEEE 754 when
+// the result is infinite or subnormal.
+//
+// Returns d.
+func (d *Double) NextToward(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleNextToward((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Or is the digitwise OR operator. Computes d = lhs | rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns d.
+func (d *Double) Or(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleOr((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Plus is the prefix plus operator. Computes d = 0 + lhs.
+//
+// See also Copy() for a quiet bitwise version of this.
+//
+// Returns d.
+func (d *Double) Plus(lhs *Double, ctx *Context) *Double {
+	C.decDoublePlus((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Power raises a Double to a power. Computes d = lhs ** rhs (lhs raised to the power of rhs).
+//
+// Like Exp(), it is computed with Number.Power(), whose restrictions apply. When rhs is an integer,
+// the result may be exact.
+//
+// Returns d.
+func (d *Double) Power(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleMath((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext(),
+		C.DOUBLE_POWER)
+	return d
+}
+
+// Quantize forces the exponent of a Double to equal that of another. Computes d = op(lhs, rhs)
+// where op adjusts the coefficient of d (by rounding or shifting) such that the exponent of d has
+// the same value as the exponent of rhs. The numerical value of d will equal lhs, except for the
+// effects of any rounding that occurred.
+//
+// If the coefficient of d would have more than DoubleDigits digits, or the exponent of rhs is out
+// of range, a NaN is returned with InvalidOperation.
+//
+// Returns d.
+func (d *Double) Quantize(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleQuantize((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Reduce has the same effect as Plus() except that the final result is set to its simplest
+// (shortest) form without changing its value. That is, a non-zero Double which has any trailing
+// zeros in the coefficient has those zeros removed by dividing the coefficient by the appropriate
+// power of ten and adjusting the exponent accordingly, and a zero has its exponent set to 0.
+//
+// Computes d = reduce(lhs).
+//
+// Returns d.
+func (d *Double) Reduce(lhs *Double, ctx *Context) *Double {
+	C.decDoubleReduce((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// Remainder returns the remainder of an integer division. Computes d = lhs % rhs, the remainder
+// of the division of lhs by rhs truncated to an integer (see DivideInteger()). The result has the
+// sign of lhs.
+//
+// Returns d.
+func (d *Double) Remainder(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleRemainder((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// RemainderNear returns the remainder of a division as defined by IEEE 754. It is identical to
+// Remainder() except that the division is rounded to the nearest integer (using RoundHalfEven)
+// rather than truncated.
+//
+// Returns d.
+func (d *Double) RemainderNear(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleRemainderNear((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Rotate rotates the digits of a Double. Computes d = lhs rotated by rhs digits. The coefficient of
+// lhs is padded with zeros on the left up to DoubleDigits digits, then rotated to the left if rhs
+// is positive, or to the right if rhs is negative. rhs must be an integer (with an exponent of 0)
+// in the range -DoubleDigits through +DoubleDigits.
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, d is set to lhs unchanged.
+//
+// Returns d.
+func (d *Double) Rotate(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleRotate((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// ScaleB scales a Double by a power of ten. Computes d = lhs * 10 ** rhs. rhs must be an integer
+// (with an exponent of 0).
+//
+// The result may overflow or underflow. Note that the coefficient of lhs is not changed, only its
+// exponent.
+//
+// Returns d.
+func (d *Double) ScaleB(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleScaleB((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// Shift shifts the digits of a Double. Computes d = lhs shifted by rhs digits. The coefficient of
+// lhs is shifted to the left if rhs is positive, or to the right if rhs is negative, zeros being
+// shifted in. Digits shifted out of the DoubleDigits most significant digits are lost. rhs must be
+// an integer (with an exponent of 0) in the range -DoubleDigits through +DoubleDigits.
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, d is set to lhs unchanged.
+//
+// Returns d.
+func (d *Double) Shift(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleShift((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// SquareRoot is the square root function. Computes d = sqrt(lhs).
+//
+// It is computed with Number.SquareRoot(): the result is correctly rounded using the RoundHalfEven
+// rounding mode, whatever the rounding mode of the context.
+//
+// Returns d.
+func (d *Double) SquareRoot(lhs *Double, ctx *Context) *Double {
+	C.decDoubleMath((*C.decDouble)(d), (*C.decDouble)(lhs), nil, ctx.DecContext(), C.DOUBLE_SQRT)
+	return d
+}
+
+// Subtract subtracts a Double from another. Computes d = lhs - rhs.
+//
+// Returns d.
+func (d *Double) Subtract(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleSubtract((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
+
+// ToIntegralExact rounds a Double to an integer, using the rounding mode of the Context. Computes
+// d = lhs, rounded to an integral value with an exponent of 0 if the exponent of lhs is negative.
+//
+// Unlike ToIntegralValue(), Inexact is set if the value changed, as required by IEEE 754.
+//
+// Returns d.
+func (d *Double) ToIntegralExact(lhs *Double, ctx *Context) *Double {
+	C.decDoubleToIntegralExact((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext())
+	return d
+}
+
+// ToIntegralValue rounds a Double to an integer, using the given rounding mode (the rounding mode
+// of the Context is not used). Computes d = lhs, rounded to an integral value with an exponent of 0
+// if the exponent of lhs is negative.
+//
+// Inexact is not set, even if the operand was rounded. The Context is only used to report an
+// InvalidOperation for a signaling NaN operand.
+//
+// Returns d.
+func (d *Double) ToIntegralValue(lhs *Double, ctx *Context, round Rounding) *Double {
+	C.decDoubleToIntegralValue((*C.decDouble)(d), (*C.decDouble)(lhs), ctx.DecContext(),
+		C.enum_rounding(round))
+	return d
+}
+
+// Xor is the digitwise exclusive OR operator. Computes d = lhs ^ rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns d.
+func (d *Double) Xor(lhs *Double, rhs *Double, ctx *Context) *Double {
+	C.decDoubleXor((*C.decDouble)(d), (*C.decDouble)(lhs), (*C.decDouble)(rhs), ctx.DecContext())
+	return d
+}
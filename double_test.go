@@ -0,0 +1,250 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec_test
+
+import (
+	dec "."
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestDouble_String(t *testing.T) {
+	var d dec.Double
+	n := dec.NewNumber(dec.DoubleDigits)
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	if s := d.FromString("123.4e7", ctx).EngString(); s != "1.234E+9" {
+		t.Fatalf("Expected 1.234E+9, got %s", s)
+	}
+	if s := d.FromString("1.23456789012345678", ctx).String(); s != "1.234567890123457" || !ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("Expected 1.234567890123457 (Inexact), got %s (%v)", s, ctx.Status())
+	}
+	if s := d.ToNumber(n).String(); s != "1.234567890123457" {
+		t.Fatalf("ToNumber: got %s", s)
+	}
+	n.FromString("-12.5", ctx)
+	if s := d.FromNumber(n, ctx).String(); s != "-12.5" {
+		t.Fatalf("FromNumber: got %s", s)
+	}
+	if len(d.Bytes()) != dec.DoubleBytes || !d.IsCanonical() {
+		t.Fatalf("Bytes: got %x", d.Bytes())
+	}
+}
+
+func TestDouble_Arithmetic(t *testing.T) {
+	testFloat(t, "Add", dec.InitDouble, methodOp((*dec.Double).Add), [][]string{
+		{"12.3", "-32.02", "-19.72", "No status"},
+		{"1E+16", "1", "1.000000000000000E+16", "Inexact"},
+		{"Infinity", "-Infinity", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Subtract", dec.InitDouble, methodOp((*dec.Double).Subtract), [][]string{
+		{"1.3", "2.07", "-0.77", "No status"},
+	})
+	testFloat(t, "Multiply", dec.InitDouble, methodOp((*dec.Double).Multiply), [][]string{
+		{"1.20", "3", "3.60", "No status"},
+		{"9E+384", "10", "Infinity", "Multiple status"},
+	})
+	testFloat(t, "Divide", dec.InitDouble, methodOp((*dec.Double).Divide), [][]string{
+		{"2", "3", "0.6666666666666667", "Inexact"},
+		{"1", "0", "Infinity", "Division by zero"},
+	})
+	testFloat(t, "DivideInteger", dec.InitDouble, methodOp((*dec.Double).DivideInteger), [][]string{
+		{"-10", "3", "-3", "No status"},
+		{"1E+20", "3", "NaN", "Division impossible"},
+	})
+	testFloat(t, "Remainder", dec.InitDouble, methodOp((*dec.Double).Remainder), [][]string{
+		{"-10", "3", "-1", "No status"},
+	})
+	testFloat(t, "RemainderNear", dec.InitDouble, methodOp((*dec.Double).RemainderNear), [][]string{
+		{"10", "6", "-2", "No status"},
+	})
+	testFloat(t, "Abs", dec.InitDouble, methodOp((*dec.Double).Abs), [][]string{
+		{"-12.3", "12.3", "No status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Max", dec.InitDouble, methodOp((*dec.Double).Max), [][]string{
+		{"NaN", "1", "1", "No status"},
+	})
+	testFloat(t, "MinMag", dec.InitDouble, methodOp((*dec.Double).MinMag), [][]string{
+		{"-10", "3", "3", "No status"},
+	})
+	testFloat(t, "Quantize", dec.InitDouble, methodOp((*dec.Double).Quantize), [][]string{
+		{"1.2345", "0.01", "1.23", "Inexact"},
+		{"123456789", "1E-10", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "NextPlus", dec.InitDouble, methodOp((*dec.Double).NextPlus), [][]string{
+		{"1", "1.000000000000001", "No status"},
+		{"9.999999999999999E+384", "Infinity", "No status"},
+	})
+	testFloat(t, "NextToward", dec.InitDouble, methodOp((*dec.Double).NextToward), [][]string{
+		{"1", "0", "0.9999999999999999", "No status"},
+		{"0", "1", "1E-398", "Multiple status"},
+	})
+	testFloat(t, "ScaleB", dec.InitDouble, methodOp((*dec.Double).ScaleB), [][]string{
+		{"7.50", "-2", "0.0750", "No status"},
+	})
+	testFloat(t, "Rotate", dec.InitDouble, methodOp((*dec.Double).Rotate), [][]string{
+		{"12345678", "-2", "7800000000123456", "No status"},
+	})
+	testFloat(t, "Invert", dec.InitDouble, methodOp((*dec.Double).Invert), [][]string{
+		{"101", "1111111111111010", "No status"},
+		{"2", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Power", dec.InitDouble, methodOp((*dec.Double).Power), [][]string{
+		{"2", "10", "1024", "No status"},
+		{"2", "0.5", "1.414213562373095", "Inexact"},
+	})
+	testFloat(t, "Exp", dec.InitDouble, methodOp((*dec.Double).Exp), [][]string{
+		{"1", "2.718281828459045", "Inexact"},
+		{"1E+4", "Infinity", "Multiple status"},
+	})
+	testFloat(t, "Ln", dec.InitDouble, methodOp((*dec.Double).Ln), [][]string{
+		{"10", "2.302585092994046", "Inexact"},
+		{"-1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "SquareRoot", dec.InitDouble, methodOp((*dec.Double).SquareRoot), [][]string{
+		{"1.00", "1.0", "No status"},
+	})
+	testFloat(t, "ToIntegralExact", dec.InitDouble, methodOp((*dec.Double).ToIntegralExact), [][]string{
+		{"2.5", "2", "Inexact"},
+	})
+}
+
+func TestDouble_FMA(t *testing.T) {
+	var a, b, d dec.Double
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	a.FromString("1.000000000000001", ctx)
+	b.FromString("-1", ctx)
+	if s := d.FMA(&a, &a, &b, ctx).String(); s != "2.000000000000001E-15" {
+		t.Fatalf("Expected 2.000000000000001E-15, got %s", s)
+	}
+}
+
+func TestDouble_Compare(t *testing.T) {
+	testFloat(t, "Compare", dec.InitDouble, methodOp((*dec.Double).Compare), [][]string{
+		{"2.1e3", "-1.24e7", "1", "No status"},
+		{"2.1e3", "2100", "0", "No status"},
+		{"2.1e3", "sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "CompareSignal", dec.InitDouble, methodOp((*dec.Double).CompareSignal), [][]string{
+		{"2.1e3", "NaN", "NaN", "Invalid operation"},
+	})
+	var x, y, d dec.Double
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	x.FromString("1.0", ctx)
+	y.FromString("-1", ctx)
+	if s := d.CompareTotal(&x, &y).String(); s != "1" {
+		t.Fatalf("CompareTotal: got %s", s)
+	}
+	if s := d.CompareTotalMag(&x, &y).String(); s != "-1" {
+		t.Fatalf("CompareTotalMag: got %s", s)
+	}
+	if s := d.CopySign(&x, &y).String(); s != "-1.0" {
+		t.Fatalf("CopySign: got %s", s)
+	}
+	if x.SameQuantum(&y) {
+		t.Fatal("SameQuantum: expected false")
+	}
+}
+
+func TestDouble_IsXYZ(t *testing.T) {
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	predicates := map[string]func(d *dec.Double) bool{
+		"Finite":    (*dec.Double).IsFinite,
+		"Infinite":  (*dec.Double).IsInfinite,
+		"Integer":   (*dec.Double).IsInteger,
+		"Logical":   (*dec.Double).IsLogical,
+		"NaN":       (*dec.Double).IsNaN,
+		"Negative":  (*dec.Double).IsNegative,
+		"Normal":    (*dec.Double).IsNormal,
+		"Positive":  (*dec.Double).IsPositive,
+		"Signaling": (*dec.Double).IsSignaling,
+		"Signed":    (*dec.Double).IsSigned,
+		"Subnormal": (*dec.Double).IsSubnormal,
+		"Zero":      (*dec.Double).IsZero,
+	}
+	for _, c := range []struct {
+		s     string
+		class dec.Class
+		is    string // predicates that must return true
+	}{
+		{"1234", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"101", dec.ClassPosNormal, "Finite Integer Logical Normal Positive"},
+		{"1.0", dec.ClassPosNormal, "Finite Normal Positive"},
+		{"-1", dec.ClassNegNormal, "Finite Integer Negative Normal Signed"},
+		{"0", dec.ClassPosZero, "Finite Integer Logical Zero"},
+		{"-0", dec.ClassNegZero, "Finite Integer Signed Zero"},
+		{"0E+10", dec.ClassPosZero, "Finite Zero"},
+		{"1E-398", dec.ClassPosSubnormal, "Finite Positive Subnormal"},
+		{"8E+369", dec.ClassPosNormal, "Finite Normal Positive"},
+		{"-Inf", dec.ClassNegInf, "Infinite Negative Signed"},
+		{"NaN", dec.ClassQNaN, "NaN"},
+		{"-sNaN12", dec.ClassSNaN, "NaN Signaling Signed"},
+		{"9999999999999999", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"1111111111111111", dec.ClassPosNormal, "Finite Integer Logical Normal Positive"},
+		{"1111111111111112", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"8000000000000000", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+	} {
+		var d dec.Double
+		d.FromString(c.s, ctx)
+		if cl := d.Class(); cl != c.class {
+			t.Fatalf("%s: expected class %s, got %s", c.s, c.class, cl)
+		}
+		for name, f := range predicates {
+			if exp := strings.Contains(" "+c.is+" ", " "+name+" "); f(&d) != exp {
+				t.Fatalf("%s: Is%s() should be %v", c.s, name, exp)
+			}
+		}
+	}
+}
+
+func TestDouble_BCD(t *testing.T) {
+	var d dec.Double
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	bcd, exp, neg := d.FromString("-123.45", ctx).ToBCD()
+	if len(bcd) != dec.DoubleDigits || exp != -2 || !neg || d.Digits() != 5 {
+		t.Fatalf("ToBCD: got %v, %d, %v", bcd, exp, neg)
+	}
+	if _, err := d.FromBCD([]byte{1, 2, 3}, 5, true); err != nil || d.String() != "-1.23E+7" {
+		t.Fatalf("FromBCD: got %s (err: %v)", &d, err)
+	}
+	if _, err := d.FromBCD([]byte{1}, 370, true); err == nil {
+		t.Fatalf("FromBCD: expected error, got %s", &d)
+	}
+	if _, err := d.FromBCD(make([]byte, dec.DoubleDigits+1), 0, true); err == nil {
+		t.Fatalf("FromBCD: expected error, got %s", &d)
+	}
+}
+
+func TestDouble_Int(t *testing.T) {
+	var d dec.Double
+	ctx := dec.NewContext(dec.InitDouble, 0)
+	if s := d.FromInt32(-2147483648).String(); s != "-2147483648" {
+		t.Fatalf("FromInt32: got %s", s)
+	}
+	if s := d.FromInt64(math.MinInt64, ctx).String(); s != "-9.223372036854776E+18" || !ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("FromInt64: got %s (%v)", s, ctx.Status())
+	}
+	if s := d.FromUint64(1234567890123456, ctx.ZeroStatus()).String(); s != "1234567890123456" || ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("FromUint64: got %s (%v)", s, ctx.Status())
+	}
+	if v := d.ToInt64(ctx, dec.RoundHalfEven); v != 1234567890123456 || ctx.ErrorStatus() != nil {
+		t.Fatalf("ToInt64: got %d (%v)", v, ctx.Status())
+	}
+	d.FromString("-2.5", ctx)
+	if v := d.ToInt32Exact(ctx, dec.RoundFloor); v != -3 || !ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("ToInt32Exact: got %d (%v)", v, ctx.Status())
+	}
+	if ctx.Rounding() != dec.RoundHalfEven {
+		t.Fatalf("ToInt32Exact: rounding mode changed")
+	}
+	if v := d.ToUint64(ctx.ZeroStatus(), dec.RoundHalfEven); v != 0 || !ctx.Status().Test(dec.InvalidOperation) {
+		t.Fatalf("ToUint64: got %d (%v)", v, ctx.Status())
+	}
+	d.FromString("9.999999999999999E+384", ctx.ZeroStatus())
+	if v := d.ToUint64(ctx, dec.RoundHalfEven); v != 0 || !ctx.Status().Test(dec.InvalidOperation) {
+		t.Fatalf("ToUint64: got %d (%v)", v, ctx.Status())
+	}
+}
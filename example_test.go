@@ -22,8 +22,16 @@ func Example_example1() {
 	)
 	ctx := dec.NewContext(dec.InitBase, digits)
 
-	a := dec.NewNumber(digits).FromString(arg1, ctx) // Should not ignore errors...
-	b := dec.NewNumber(digits).FromString(arg2, ctx)
+	a, err := dec.ParseNumber(arg1, ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	b, err := dec.ParseNumber(arg2, ctx)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
 
 	a.Add(a, b, ctx) // a=a+b
 
@@ -175,6 +183,28 @@ func Example_NewNumber() {
 	// 0
 }
 
+// ParseNumber() example
+func ExampleParseNumber() {
+	ctx := dec.NewContext(dec.InitDecimal64, 0)
+	for _, s := range []string{"3.14", "1.23456789012345678", "12a"} {
+		n, err := dec.ParseNumber(s, ctx.ZeroStatus())
+		if err != nil {
+			fmt.Printf("%s: %v\n", s, err)
+			continue
+		}
+		fmt.Printf("%s: %s (%v)\n", s, n, ctx.Status())
+	}
+	// ParseNumberExact does not round
+	n, _ := dec.ParseNumberExact("1.23456789012345678", ctx.ZeroStatus())
+	fmt.Println(n)
+
+	// Output:
+	// 3.14: 3.14 (No status)
+	// 1.23456789012345678: 1.234567890123457 (Multiple status)
+	// 12a: Conversion syntax
+	// 1.23456789012345678
+}
+
 // Accpeted formats and error handling demo.
 func ExampleNumber_FromString() {
 	// new context
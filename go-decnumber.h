@@ -18,3 +18,7 @@
 
 #define DECPRINT 0
 #define DECEXTFLAG 1
+
+// All the Go files share the same definition of decNumber, so that cgo sees consistent types. 34
+// digits is enough for the decimalXX conversions of all formats.
+#define DECNUMDIGITS 34
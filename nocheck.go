@@ -0,0 +1,17 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build nocheck
+
+package dec
+
+// check is a no-op when built with the nocheck tag. See check.go.
+func (n *Number) check(ctx *Context) bool {
+	return true
+}
+
+// checkOperand is a no-op when built with the nocheck tag. See check.go.
+func (n *Number) checkOperand(lhs *Number, ctx *Context) bool {
+	return true
+}
@@ -0,0 +1,10 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// +build !decdebug
+
+package dec
+
+// debug is false unless the package is built with the decdebug tag. See debug.go.
+const debug = false
@@ -8,18 +8,84 @@ package dec
 #include "go-decnumber.h"
 #include "decNumber.h"
 #include <stdlib.h>
+#include <stdint.h>
 #include <string.h>
 
 // Helpers for go code
-decNumber * new_decNumber(int32_t digits) {
-	return malloc( (sizeof(decNumber)-DECNUMUNITS*sizeof(decNumberUnit))
-				+ ((size_t)digits+DECDPUN-1) / DECDPUN * sizeof(decNumberUnit) );
+
+// unit_base returns the value of 10^DECDPUN, the base of a decNumberUnit.
+static uint64_t unit_base() {
+	uint64_t base = 1;
+	int k;
+	for (k = 0; k < DECDPUN; k++) base *= 10;
+	return base;
+}
+
+// decNumberFromUInt64 is the 64 bits version of decNumberFromUInt32.
+decNumber * decNumberFromUInt64(decNumber *dn, uint64_t uin) {
+	decNumberUnit *up;
+	uint64_t t, base = unit_base();
+	decNumberZero(dn);
+	if (uin == 0) return dn;
+	for (dn->digits = 0, t = uin; t > 0; t /= 10) dn->digits++;
+	for (up = dn->lsu; uin > 0; up++) {
+		*up = (decNumberUnit)(uin % base);
+		uin /= base;
+	}
+	return dn;
+}
+
+// decNumberGetUInt64 sets *u to the coefficient of dn. Returns 0 if dn is not a finite number
+// with an exponent of 0, or if its coefficient does not fit in a uint64_t.
+int decNumberGetUInt64(const decNumber *dn, uint64_t *u) {
+	int32_t i;
+	uint64_t v = 0, base = unit_base();
+	if (dn->bits&DECSPECIAL || dn->digits > 20 || dn->exponent != 0) return 0;
+	for (i = (dn->digits+DECDPUN-1) / DECDPUN - 1; i >= 0; i--) {
+		if (v > (UINT64_MAX - dn->lsu[i]) / base) return 0;
+		v = v * base + dn->lsu[i];
+	}
+	*u = v;
+	return 1;
+}
+
+// decNumberFromBits sets dn to the logical number whose digits are the bits of u.
+decNumber * decNumberFromBits(decNumber *dn, uint64_t u) {
+	decNumberUnit *up, pow = 1;
+	int32_t d;
+	decNumberZero(dn);
+	for (d = 0, up = dn->lsu; u != 0; d++, u >>= 1) {
+		if (d > 0 && d % DECDPUN == 0) {
+			*++up = 0;
+			pow = 1;
+		}
+		if (u & 1) *up += pow;
+		pow *= 10;
+	}
+	if (d > 0) dn->digits = d;
+	return dn;
+}
+
+// decNumberGetBits sets *u to the bit pattern represented by the logical number dn. Returns 0 if
+// dn is not a valid logical operand or has more than 64 digits.
+int decNumberGetBits(const decNumber *dn, uint64_t *u) {
+	int32_t d;
+	decNumberUnit unit = 0;
+	uint64_t v = 0;
+	if (dn->bits & (DECSPECIAL|DECNEG) || dn->digits > 64 || dn->exponent != 0) return 0;
+	for (d = 0; d < dn->digits; d++, unit /= 10) {
+		if (d % DECDPUN == 0) unit = dn->lsu[d / DECDPUN];
+		if (unit % 10 > 1) return 0;
+		v |= (uint64_t)(unit % 10) << d;
+	}
+	*u = v;
+	return 1;
 }
 */
 import "C"
 
 import (
-	"runtime"
+	"math"
 	"unsafe"
 )
 
@@ -34,50 +100,158 @@ import (
 // functions must also fit within these bounds.
 //
 // Numbers should be created via the NewNumber() function.
+//
+// A Number keeps track of the storage space it was created with. Methods taking a Context as an
+// argument check that the receiver has enough storage space for a result rounded to the Context's
+// precision. If not, the receiver is set to NaN and InsufficientStorage is set in the Context
+// status. A few methods, like ScaleB(), copy their operand unrounded into the receiver, which must
+// then also have enough storage space for the digits of the operand. Methods that do not take a
+// Context argument, like Copy() or FromInt64(), will reallocate the receiver if needed. These
+// checks can be disabled by building the package with the nocheck build tag.
 type Number struct {
-	dn *C.decNumber // Pointer to the embedded decNumber
+	dn   *C.decNumber // Pointer to the embedded decNumber
+	size int32        // Storage space, in digits
 }
 
-// NewNumber returns, as a *Number, a new uinitialized Number with enough storage space for the
-// requested number of digits. If memory cannot be allocated for the new number, the function will
-// panic.
+// NewNumber returns, as a *Number, a new Number set to zero with enough storage space for the
+// requested number of digits (at least 1).
+//
+// The storage space is allocated on the Go heap and is managed by the garbage collector like any
+// other Go value. It does not contain any Go pointer, so it can safely be passed to C code for the
+// duration of a call, but C code must not retain a pointer to it (see DecNumber()).
 //
-// Since the Number is unitialized, its value is not valid and must be initialized from some source
-// before using it as an operand in an arithmetic operation. This is not necessary if the Number is
-// to be used as the result of such operation.
+// Since the Number is set to zero, it is always a valid operand in arithmetic operations.
 func NewNumber(digits int32) *Number {
 	num := &Number{}
-	// required structure size do hold the requested amount of digits
-	num.dn = C.new_decNumber(C.int32_t(digits))
-	if num.dn == nil {
-		panic("Malloc failed")
+	if digits < 1 {
+		digits = 1
 	}
-	runtime.SetFinalizer(num, (*Number).finalize)
+	num.dn = newDecNumber(digits)
+	num.size = digits
 	return num
 }
 
-func (n *Number) finalize() {
-	if n.dn != nil {
-		C.free(unsafe.Pointer(n.dn))
-		n.dn = nil
+// ParseNumber returns a new Number set to the value of s, with enough storage space for the
+// precision of ctx. This is a shorthand for NewNumber(ctx.Digits()).FromString(s, ctx).
+//
+// If s is not a valid number, the returned Number is a quiet NaN, ConversionSyntax is set in the
+// Context status and a *ContextError with the ConversionSyntax status is returned. Other conditions
+// (Overflow, Inexact, etc.) are only reported in the Context status.
+func ParseNumber(s string, ctx *Context) (*Number, error) {
+	return parseNumber(s, ctx, ctx.Digits())
+}
+
+// ParseNumberExact is like ParseNumber, except that the Number is sized from the coefficient of s
+// and is never rounded, regardless of the precision of ctx. See ExactAdd() for details about exact
+// operations.
+//
+// The resulting Number may have less storage space than required by ctx: it is meant to be used as
+// an operand, not as the result of an operation.
+func ParseNumberExact(s string, ctx *Context) (*Number, error) {
+	var digits int32
+	for i := 0; i < len(s) && s[i] != 'e' && s[i] != 'E'; i++ {
+		if '0' <= s[i] && s[i] <= '9' {
+			digits++
+		}
+	}
+	if ctx.ctx.clamp != 0 {
+		// NaN payloads must be shorter than the precision
+		digits++
 	}
+	if digits > MaxDigits {
+		digits = MaxDigits
+	}
+	return parseNumber(s, ctx, digits)
 }
 
-// DecNumber returns a pointer to the underlying decNumber C struct.
-func (n *Number) DecNumber() *C.decNumber {
+// parseNumber returns a new Number set to the value of s, using the precision of ctx set to the
+// requested number of digits. The status of the conversion is merged into ctx.
+func parseNumber(s string, ctx *Context, digits int32) (*Number, error) {
+	n := NewNumber(digits)
+	str := C.CString(s)
+	defer C.free(unsafe.Pointer(str))
+	set := ctx.ctx
+	set.digits = C.int32_t(n.size)
+	set.status = 0
+	C.decNumberFromString(n.ptr(), str, &set)
+	status := Status(set.status)
+	ctx.Status().Set(status)
+	if status.Test(ConversionSyntax) {
+		return n, &ContextError{ConversionSyntax}
+	}
+	return n, nil
+}
+
+// newDecNumber allocates on the Go heap a decNumber with enough storage space for the requested
+// number of digits. The decNumber is set to zero.
+func newDecNumber(digits int32) *C.decNumber {
+	var dn C.decNumber
+	// required structure size to hold the requested amount of digits
+	size := unsafe.Offsetof(dn.lsu) + (uintptr(digits)+C.DECDPUN-1)/C.DECDPUN*unsafe.Sizeof(dn.lsu[0])
+	if size < unsafe.Sizeof(dn) {
+		size = unsafe.Sizeof(dn)
+	}
+	// use uint64's for proper alignment. The GC will not scan it for pointers.
+	buf := make([]uint64, (size+7)/8)
+	res := (*C.decNumber)(unsafe.Pointer(&buf[0]))
+	// make() zeroes the storage, a zero needs only one digit
+	res.digits = 1
+	return res
+}
+
+// grow makes sure that n has enough storage space for the requested number of digits. If not, n
+// is reallocated and its value is set to zero.
+func (n *Number) grow(digits int32) {
+	if debug && n.dn == nil {
+		panic(errFreed)
+	}
+	if digits <= n.size {
+		return
+	}
+	n.dn = newDecNumber(digits)
+	n.size = digits
+}
+
+// errFreed is the panic value for uses of a freed Number in debug builds.
+const errFreed = "dec: use of a freed or zero value Number"
+
+// ptr returns a pointer to the underlying decNumber of n. In debug builds, it panics if n has been
+// freed or was not created by NewNumber().
+func (n *Number) ptr() *C.decNumber {
+	if debug && n.dn == nil {
+		panic(errFreed)
+	}
 	return n.dn
 }
 
+// Free releases the storage space of n. It is not required since the storage space is managed by
+// the garbage collector, but it allows the early release of large Numbers that are referenced
+// longer than needed. Free is idempotent.
+//
+// n must not be used after Free has been called. Using a freed Number is not detected unless the
+// package is built with the decdebug build tag, in which case any method called on a freed Number,
+// or on a Number not created by NewNumber(), panics.
+func (n *Number) Free() {
+	n.dn = nil
+	n.size = 0
+}
+
+// DecNumber returns a pointer to the underlying decNumber C struct. Since it lives in Go memory,
+// the pointer must not be retained by C code after a call returns.
+func (n *Number) DecNumber() *C.decNumber {
+	return n.ptr()
+}
+
 // Digits() returns the number of digits in a Number.
 func (n *Number) Digits() int32 {
-	return int32(n.dn.digits)
+	return int32(n.ptr().digits)
 }
 
 // Zero sets the value of a Number to zero.
 func (n *Number) Zero() *Number {
-	// C.decNumberZero(n.dn)
+	// C.decNumberZero(n.ptr())
 	// Reimplemented in Go for speed
-	dn := n.dn
+	dn := n.ptr()
 	dn.digits = 1
 	dn.exponent = 0
 	dn.bits = 0
@@ -89,16 +263,84 @@ func (n *Number) Zero() *Number {
 // needed (that is, there will be just one digit before any decimal point). It implements the
 // to-scientific-string conversion.
 func (n *Number) String() string {
-	nDigits := int(n.dn.digits)
+	nDigits := int(n.ptr().digits)
 	if nDigits == 0 {
 		nDigits++
 	}
 	str := make([]byte, nDigits+14) // TODO: escapes to heap, need to check how fmt uses sync.Pool
 	pStr := (*C.char)(unsafe.Pointer(&str[0]))
-	C.decNumberToString(n.dn, pStr)
+	C.decNumberToString(n.ptr(), pStr)
 	return string(str[:C.strlen(pStr)])
 }
 
+// FromBits sets n to the logical Number whose digits are the bits of b (that is, a finite number
+// with an exponent of 0 and only 0 or 1 digits, the least significant digit being bit 0 of b).
+// The resulting Number can be used as an operand for logical functions like And() or Or(). If n
+// has storage for less than 64 digits, it is reallocated.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) FromBits(b uint64) *Number {
+	n.grow(64)
+	C.decNumberFromBits(n.ptr(), C.uint64_t(b))
+	return n
+}
+
+// FromInt32 converts a signed 32 bits integer to a Number. If n has storage for less than 10
+// digits, it is reallocated.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) FromInt32(i int32) *Number {
+	n.grow(10)
+	C.decNumberFromInt32(n.ptr(), C.int32_t(i))
+	return n
+}
+
+// FromUint32 converts an unsigned 32 bits integer to a Number. If n has storage for less than 10
+// digits, it is reallocated.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) FromUint32(u uint32) *Number {
+	n.grow(10)
+	C.decNumberFromUInt32(n.ptr(), C.uint32_t(u))
+	return n
+}
+
+// FromInt64 converts a signed 64 bits integer to a Number. If n has storage for less than 19
+// digits, it is reallocated.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) FromInt64(i int64) *Number {
+	n.grow(19)
+	if i >= 0 {
+		C.decNumberFromUInt64(n.ptr(), C.uint64_t(i))
+		return n
+	}
+	// -i overflows for math.MinInt64, but its two's complement is the right magnitude
+	C.decNumberFromUInt64(n.ptr(), C.uint64_t(-uint64(i)))
+	n.ptr().bits = C.DECNEG
+	return n
+}
+
+// FromUint64 converts an unsigned 64 bits integer to a Number. If n has storage for less than 20
+// digits, it is reallocated.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) FromUint64(u uint64) *Number {
+	n.grow(20)
+	C.decNumberFromUInt64(n.ptr(), C.uint64_t(u))
+	return n
+}
+
 // FromString converts a string to a Number. It implements the to-number conversion from the
 // arithmetic specification.
 //
@@ -106,12 +348,74 @@ func (n *Number) String() string {
 // correct error (Underflow or Overflow) can be reported or rounding applied, as necessary. If bad
 // syntax is detected, the result will be a quiet NaN.
 func (n *Number) FromString(s string, ctx *Context) *Number {
+	if !n.check(ctx) {
+		return n
+	}
 	str := C.CString(s)
 	defer C.free(unsafe.Pointer(str))
-	C.decNumberFromString(n.dn, str, ctx.DecContext())
+	C.decNumberFromString(n.ptr(), str, ctx.DecContext())
 	return n
 }
 
+// ToBits converts a logical Number back to a bit pattern, the least significant digit of n giving
+// bit 0 of the result. This is the reverse of FromBits().
+//
+// If n is not a valid logical operand (see And()), or if it has more than 64 digits,
+// InvalidOperation is set in the Context status and 0 is returned.
+func (n *Number) ToBits(ctx *Context) uint64 {
+	var u C.uint64_t
+	if C.decNumberGetBits(n.ptr(), &u) == 0 {
+		ctx.Status().Set(InvalidOperation)
+		return 0
+	}
+	return uint64(u)
+}
+
+// ToInt32 converts a Number to a signed 32 bits integer.
+//
+// If n is not a finite integer with an exponent of 0 (that is, if it is a NaN, an infinite, has a
+// fractional part or is written in exponential notation like 1E+3), or if it is out of range,
+// InvalidOperation is set in the Context status and 0 is returned.
+func (n *Number) ToInt32(ctx *Context) int32 {
+	return int32(C.decNumberToInt32(n.ptr(), ctx.DecContext()))
+}
+
+// ToUint32 converts a Number to an unsigned 32 bits integer.
+//
+// Same as ToInt32(). Negative numbers other than -0 are out of range.
+func (n *Number) ToUint32(ctx *Context) uint32 {
+	return uint32(C.decNumberToUInt32(n.ptr(), ctx.DecContext()))
+}
+
+// ToInt64 converts a Number to a signed 64 bits integer.
+//
+// Same as ToInt32().
+func (n *Number) ToInt64(ctx *Context) int64 {
+	var u C.uint64_t
+	if C.decNumberGetUInt64(n.ptr(), &u) != 0 {
+		if !n.IsNegative() && u <= math.MaxInt64 {
+			return int64(u)
+		}
+		if n.IsNegative() && u <= -math.MinInt64 {
+			return int64(-uint64(u))
+		}
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
+// ToUint64 converts a Number to an unsigned 64 bits integer.
+//
+// Same as ToInt32(). Negative numbers other than -0 are out of range.
+func (n *Number) ToUint64(ctx *Context) uint64 {
+	var u C.uint64_t
+	if C.decNumberGetUInt64(n.ptr(), &u) != 0 && (!n.IsNegative() || u == 0) {
+		return uint64(u)
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
 //
 // Pooling facilities
 //
@@ -151,7 +455,9 @@ func (p *NumberPool) Putn(n ...*Number) {
 //
 // returns n.
 func (n *Number) Abs(lhs *Number, ctx *Context) *Number {
-	C.decNumberAbs(n.dn, lhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberAbs(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -159,7 +465,9 @@ func (n *Number) Abs(lhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) Add(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberAdd(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberAdd(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -170,13 +478,15 @@ func (n *Number) Add(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) And(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberAnd(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberAnd(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
 // Class returns the Class ot a Number
 func (n *Number) Class(ctx *Context) Class {
-	return Class(C.decNumberClass(n.dn, ctx.DecContext()))
+	return Class(C.decNumberClass(n.ptr(), ctx.DecContext()))
 }
 
 // Compare compares two numbers numerically. If the lhs is less than the rhs then the number will be
@@ -186,7 +496,9 @@ func (n *Number) Class(ctx *Context) Class {
 //
 // Returns n.
 func (n *Number) Compare(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberCompare(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberCompare(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -195,7 +507,9 @@ func (n *Number) Compare(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) CompareSignal(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberCompareSignal(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberCompareSignal(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -208,7 +522,9 @@ func (n *Number) CompareSignal(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) CompareTotal(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberCompareTotal(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberCompareTotal(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -218,7 +534,63 @@ func (n *Number) CompareTotal(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) CompareTotalMag(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberCompareTotalMag(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberCompareTotalMag(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Copy copies a Number. Computes n = lhs.
+//
+// This is a quiet bitwise operation: no rounding is performed and no error is possible. If n does
+// not have enough storage space for the digits of lhs, it is reallocated with enough space.
+//
+// Returns n.
+func (n *Number) Copy(lhs *Number) *Number {
+	n.grow(lhs.Digits())
+	C.decNumberCopy(n.ptr(), lhs.ptr())
+	return n
+}
+
+// CopyAbs copies the absolute value of a Number. Computes n = abs(lhs).
+//
+// This is a quiet bitwise operation: no rounding is performed and no error is possible, even if
+// lhs is a signaling NaN. If n does not have enough storage space for the digits of lhs, it is
+// reallocated with enough space.
+//
+// Returns n.
+func (n *Number) CopyAbs(lhs *Number) *Number {
+	n.grow(lhs.Digits())
+	C.decNumberCopyAbs(n.ptr(), lhs.ptr())
+	return n
+}
+
+// CopyNegate copies a Number with its sign inverted. Computes n = -lhs.
+//
+// This is a quiet bitwise operation: no rounding is performed and no error is possible, even if
+// lhs is a signaling NaN. If n does not have enough storage space for the digits of lhs, it is
+// reallocated with enough space.
+//
+// Returns n.
+func (n *Number) CopyNegate(lhs *Number) *Number {
+	n.grow(lhs.Digits())
+	C.decNumberCopyNegate(n.ptr(), lhs.ptr())
+	return n
+}
+
+// CopySign copies a Number with the sign of another. Computes n = lhs with the sign of rhs.
+//
+// This is a quiet bitwise operation: no rounding is performed and no error is possible, even if
+// lhs or rhs is a signaling NaN. If n does not have enough storage space for the digits of lhs,
+// it is reallocated with enough space.
+//
+// Returns n.
+func (n *Number) CopySign(lhs *Number, rhs *Number) *Number {
+	// rhs may be n, get its sign before growing n
+	sign := rhs.ptr().bits & C.DECNEG
+	n.grow(lhs.Digits())
+	C.decNumberCopy(n.ptr(), lhs.ptr())
+	n.ptr().bits = n.ptr().bits&^C.DECNEG | sign
 	return n
 }
 
@@ -226,7 +598,289 @@ func (n *Number) CompareTotalMag(lhs *Number, rhs *Number, ctx *Context) *Number
 //
 // Returns n.
 func (n *Number) Divide(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberDivide(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberDivide(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// DivideInteger divides one number by another and returns the integer part of the result.
+// Computes n = lhs / rhs, truncated to an integer with exponent 0.
+//
+// If the integer part of the result has more than Context.Digits() digits, a NaN is returned with
+// DivisionImpossible.
+//
+// Returns n.
+func (n *Number) DivideInteger(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberDivideInteger(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// ExactAdd adds two numbers without rounding. Computes n = lhs + rhs.
+//
+// The precision used for the operation is computed from the operands so that the result is always
+// exact, regardless of the precision of the Context. n is reallocated if it does not have enough
+// storage space for the result. The Context is used only for status reporting and its exponent
+// limits: Rounded and Inexact will never be set unless the result overflows or underflows.
+//
+// If the exact result would need more than MaxDigits digits, n is set to NaN and
+// InsufficientStorage is set in the Context status.
+//
+// Returns n.
+func (n *Number) ExactAdd(lhs *Number, rhs *Number, ctx *Context) *Number {
+	return n.exact(addDigits(lhs, rhs), ctx, func(res *C.decNumber, set *C.decContext) {
+		C.decNumberAdd(res, lhs.ptr(), rhs.ptr(), set)
+	})
+}
+
+// ExactMultiply multiplies two numbers without rounding. Computes n = lhs * rhs.
+//
+// See ExactAdd() for details about exact operations.
+//
+// Returns n.
+func (n *Number) ExactMultiply(lhs *Number, rhs *Number, ctx *Context) *Number {
+	digits := int64(lhs.Digits()) + int64(rhs.Digits())
+	if lhs.IsSpecial() || rhs.IsSpecial() {
+		digits = maxDigits(lhs, rhs)
+	}
+	return n.exact(digits, ctx, func(res *C.decNumber, set *C.decContext) {
+		C.decNumberMultiply(res, lhs.ptr(), rhs.ptr(), set)
+	})
+}
+
+// ExactSubtract subtracts a number from another without rounding. Computes n = lhs - rhs.
+//
+// See ExactAdd() for details about exact operations.
+//
+// Returns n.
+func (n *Number) ExactSubtract(lhs *Number, rhs *Number, ctx *Context) *Number {
+	return n.exact(addDigits(lhs, rhs), ctx, func(res *C.decNumber, set *C.decContext) {
+		C.decNumberSubtract(res, lhs.ptr(), rhs.ptr(), set)
+	})
+}
+
+// exact calls f with a copy of ctx whose precision is set to the requested number of digits, and
+// with a result decNumber having enough storage space for it. The status of the operation is
+// merged into ctx. If digits exceeds MaxDigits, n is set to NaN with InsufficientStorage instead.
+func (n *Number) exact(digits int64, ctx *Context, f func(res *C.decNumber, set *C.decContext)) *Number {
+	res := n.ptr()
+	if digits > MaxDigits {
+		n.insufficientStorage(ctx)
+		return n
+	}
+	if int32(digits) > n.size {
+		// n may also be an operand, do not overwrite it.
+		res = newDecNumber(int32(digits))
+	}
+	set := ctx.ctx
+	set.digits = C.int32_t(digits)
+	set.status = 0
+	f(res, &set)
+	if res != n.dn {
+		n.dn, n.size = res, int32(digits)
+	}
+	ctx.Status().Set(Status(set.status))
+	return n
+}
+
+// insufficientStorage sets n to NaN and InsufficientStorage in the Context status.
+func (n *Number) insufficientStorage(ctx *Context) {
+	dn := n.ptr()
+	dn.digits = 1
+	dn.exponent = 0
+	dn.bits = C.DECNAN
+	dn.lsu[0] = 0
+	ctx.Status().Set(InsufficientStorage)
+}
+
+// addDigits returns the number of digits required to hold the exact result of an addition or
+// subtraction of lhs and rhs.
+func addDigits(lhs *Number, rhs *Number) int64 {
+	if lhs.IsSpecial() || rhs.IsSpecial() {
+		return maxDigits(lhs, rhs)
+	}
+	// zeros only matter for the exponent of the result. Exponents and digits are int32, but the
+	// distance between them may not fit.
+	bottom := int64(lhs.ptr().exponent)
+	if e := int64(rhs.ptr().exponent); e < bottom {
+		bottom = e
+	}
+	top := bottom
+	for _, x := range [...]*Number{lhs, rhs} {
+		if t := int64(x.ptr().exponent) + int64(x.Digits()); !x.IsZero() && t > top {
+			top = t
+		}
+	}
+	// one more digit for the carry
+	return top - bottom + 1
+}
+
+// maxDigits returns the largest number of digits of lhs and rhs.
+func maxDigits(lhs *Number, rhs *Number) int64 {
+	if lhs.Digits() > rhs.Digits() {
+		return int64(lhs.Digits())
+	}
+	return int64(rhs.Digits())
+}
+
+// Exp is the exponential function. Computes n = e ** lhs (e raised to the power of lhs).
+//
+// Mathematical function restrictions apply; a NaN is returned with InvalidContext if the
+// Context settings exceed the MaxMath bounds, or with InvalidOperation if lhs does.
+//
+// The final result is rounded according to the context; it will almost always be correctly rounded,
+// but may be up to 1 ulp in error in rare cases.
+//
+// Returns n.
+func (n *Number) Exp(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberExp(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// FMA is the fused multiply-add operator. Computes n = (lhs * rhs) + fhs.
+//
+// The multiplication is carried out first and is exact, so this operation has only the one,
+// final, rounding.
+//
+// Returns n.
+func (n *Number) FMA(lhs *Number, rhs *Number, fhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberFMA(n.ptr(), lhs.ptr(), rhs.ptr(), fhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Invert is the digitwise logical inversion operator. Computes n = ~lhs: each digit of lhs is
+// inverted (a 0 digit becomes 1 and vice versa), after padding lhs with zeros on the left up to
+// Context.Digits() digits.
+//
+// Logical function restrictions apply; a NaN is returned with InvalidOperation if a restriction is
+// violated.
+//
+// Returns n.
+func (n *Number) Invert(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberInvert(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Ln is the natural logarithm function. Computes n = ln(lhs).
+//
+// Mathematical function restrictions apply; a NaN is returned with InvalidContext if the
+// Context settings exceed the MaxMath bounds, or with InvalidOperation if lhs does.
+//
+// The final result is rounded according to the context; it will almost always be correctly rounded,
+// but may be up to 1 ulp in error in rare cases.
+//
+// Returns n.
+func (n *Number) Ln(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberLn(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Log10 is the logarithm in base ten function. Computes n = log10(lhs).
+//
+// Mathematical function restrictions apply; a NaN is returned with InvalidContext if the
+// Context settings exceed the MaxMath bounds, or with InvalidOperation if lhs does.
+//
+// The result will be exact if lhs is an exact power of ten. Otherwise, it is rounded according to
+// the context; it will almost always be correctly rounded, but may be up to 1 ulp in error in rare
+// cases.
+//
+// Returns n.
+func (n *Number) Log10(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberLog10(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// LogB returns the adjusted exponent of a number, according to IEEE 754 rules. That is, the
+// exponent returned is calculated as if the decimal point followed the first significant digit
+// (so, for example, if lhs were 250 then n would be 2).
+//
+// If lhs is infinite, n is set to +Infinity. If lhs is a zero, n is set to -Infinity and
+// DivisionByZero is set. If lhs is a NaN, it is handled as usual.
+//
+// Returns n.
+func (n *Number) LogB(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberLogB(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Max compares two numbers numerically and sets n to the larger. Computes n = max(lhs, rhs).
+//
+// If the numbers compare equal then number is chosen with regard to sign and exponent. Unusually,
+// if one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns n.
+func (n *Number) Max(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberMax(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// MaxMag compares the magnitude of two numbers numerically and sets n to the larger. It is
+// identical to Max() except that the signs of the operands are ignored and taken to be 0
+// (non-negative).
+//
+// Returns n.
+func (n *Number) MaxMag(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberMaxMag(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Min compares two numbers numerically and sets n to the smaller. Computes n = min(lhs, rhs).
+//
+// If the numbers compare equal then number is chosen with regard to sign and exponent. Unusually,
+// if one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns n.
+func (n *Number) Min(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberMin(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// MinMag compares the magnitude of two numbers numerically and sets n to the smaller. It is
+// identical to Min() except that the signs of the operands are ignored and taken to be 0
+// (non-negative).
+//
+// Returns n.
+func (n *Number) MinMag(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberMinMag(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Minus is the prefix minus operator. Computes n = 0 - lhs.
+//
+// See also CopyNegate() for a quiet bitwise version of this.
+//
+// Unlike CopyNegate(), the result is rounded according to the Context, and a signaling NaN sets
+// InvalidOperation.
+//
+// Returns n.
+func (n *Number) Minus(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberMinus(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -234,7 +888,75 @@ func (n *Number) Divide(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) Multiply(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberMultiply(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberMultiply(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// NextMinus returns the next representable number in the direction of -Infinity. Computes n =
+// the closest value to lhs that is less than lhs.
+//
+// This is computed as though by subtracting an infinitesimal amount from lhs using RoundFloor,
+// except that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns n.
+func (n *Number) NextMinus(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberNextMinus(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// NextPlus returns the next representable number in the direction of +Infinity. Computes n =
+// the closest value to lhs that is greater than lhs.
+//
+// This is computed as though by adding an infinitesimal amount to lhs using RoundCeiling, except
+// that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns n.
+func (n *Number) NextPlus(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberNextPlus(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// NextToward returns the next representable number from lhs in the direction of rhs. If rhs
+// compares equal to lhs, n is set to lhs with the sign of rhs.
+//
+// This is computed as though by adding or subtracting an infinitesimal amount to lhs. Unlike
+// NextPlus() and NextMinus(), Overflow, Underflow, Subnormal, Inexact and Rounded are set as
+// required by IEEE 754 when the result is infinite or subnormal.
+//
+// Returns n.
+func (n *Number) NextToward(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberNextToward(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Normalize is a synonym for Reduce(), kept for compatibility with decNumberNormalize.
+//
+// Returns n.
+func (n *Number) Normalize(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberNormalize(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Or is the digitwise OR operator. Computes n = lhs | rhs.
+//
+// Logical function restrictions apply; a NaN is returned with InvalidOperation if a restriction is
+// violated.
+//
+// Returns n.
+func (n *Number) Or(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberOr(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -250,7 +972,7 @@ func (n *Number) IsCanonical() bool {
 // Returns true if the number is finite, or false otherwise (that is, it is an infinity or a NaN).
 // No error is possible.
 func (n *Number) IsFinite() bool {
-	return n.dn.bits&C.DECSPECIAL == 0
+	return n.ptr().bits&C.DECSPECIAL == 0
 }
 
 // IsInfinite tests whether a number is infinite.
@@ -258,12 +980,12 @@ func (n *Number) IsFinite() bool {
 // Returns true if the number is infinite, or false otherwise (that is, it is a finite number or a
 // NaN). No error is possible.
 func (n *Number) IsInfinite() bool {
-	return n.dn.bits&C.DECINF != 0
+	return n.ptr().bits&C.DECINF != 0
 }
 
 // IsNaN tests whether a number is a NaN (quiet or signaling).
 func (n *Number) IsNaN() bool {
-	return n.dn.bits&(C.DECNAN|C.DECSNAN) != 0
+	return n.ptr().bits&(C.DECNAN|C.DECSNAN) != 0
 }
 
 // IsNegative tests whether a number is negative (either minus zero, less than zero, or a NaN with a
@@ -272,39 +994,39 @@ func (n *Number) IsNaN() bool {
 // Note that for the Float types, this is called (for example) IsSigned(), and IsNegative() does not
 // include zeros or NaNs.
 func (n *Number) IsNegative() bool {
-	return n.dn.bits&C.DECNEG != 0
+	return n.ptr().bits&C.DECNEG != 0
 }
 
 // IsNormal tests whether a number is normal (that is, finite, non-zero, and not subnormal).
 func (n *Number) IsNormal(ctx *Context) bool {
-	return C.decNumberIsNormal(n.dn, ctx.DecContext()) != 0
+	return C.decNumberIsNormal(n.ptr(), ctx.DecContext()) != 0
 }
 
 // IsQNaN tests whether a number is a Quiet NaN.
 func (n *Number) IsQNaN() bool {
-	return n.dn.bits&C.DECNAN != 0
+	return n.ptr().bits&C.DECNAN != 0
 }
 
 // IsSNaN tests whether a number is a Signaling NaN.
 func (n *Number) IsSNaN() bool {
-	return n.dn.bits&C.DECSNAN != 0
+	return n.ptr().bits&C.DECSNAN != 0
 }
 
 // IsSpecial tests whether a number has a special value (Infinity or NaN); it is the inversion of
 // IsFinite()
 func (n *Number) IsSpecial() bool {
-	return n.dn.bits&C.DECSPECIAL != 0
+	return n.ptr().bits&C.DECSPECIAL != 0
 }
 
 // IsSubnormal tests whether a number is subnormal (that is, finite, non-zero, and magnitude
 // less than 10^emin).
 func (n *Number) IsSubnormal(ctx *Context) bool {
-	return C.decNumberIsSubnormal(n.dn, ctx.DecContext()) != 0
+	return C.decNumberIsSubnormal(n.ptr(), ctx.DecContext()) != 0
 }
 
 // IsZero tests whether a number is a zero (either positive or negative).
 func (n *Number) IsZero() bool {
-	return n.dn.lsu[0] == 0 && n.dn.digits == 1 && n.dn.bits&C.DECSPECIAL == 0
+	return n.ptr().lsu[0] == 0 && n.ptr().digits == 1 && n.ptr().bits&C.DECSPECIAL == 0
 }
 
 // Radix returns the radix (number base) used by the dec package. This always returns
@@ -313,6 +1035,21 @@ func (n *Number) Radix() int {
 	return 10
 }
 
+// Plus is the prefix plus operator. Computes n = 0 + lhs.
+//
+// See also Copy() for a quiet bitwise version of this.
+//
+// Unlike Copy(), the result is rounded according to the Context, and a signaling NaN sets
+// InvalidOperation. This can be used to round a number to the Context precision.
+//
+// Returns n.
+func (n *Number) Plus(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberPlus(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
 // Power raises a number to a power. Computes n = lhs ** rhs (lhs raised to the power of rhs).
 //
 // Mathematical function restrictions apply; a NaN is returned with Invalidoperation if a
@@ -329,7 +1066,70 @@ func (n *Number) Radix() int {
 //
 // Returns n.
 func (n *Number) Power(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberPower(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberPower(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Quantize forces the exponent of a number to equal that of another. Computes n = op(lhs, rhs)
+// where op adjusts the coefficient of n (by rounding or shifting) such that the exponent of n has
+// the same value as the exponent of rhs. The numerical value of n will equal lhs, except for the
+// effects of any rounding that occurred.
+//
+// If the coefficient of n would have more than Context.Digits() digits, or the exponent of rhs is
+// out of the range allowed by the Context, a NaN is returned with InvalidOperation.
+//
+// Returns n.
+func (n *Number) Quantize(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberQuantize(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Reduce has the same effect as Plus() except that the final result is set to its simplest
+// (shortest) form without changing its value. That is, a non-zero number which has any trailing
+// zeros in the coefficient has those zeros removed by dividing the coefficient by the appropriate
+// power of ten and adjusting the exponent accordingly, and a zero has its exponent set to 0.
+//
+// Computes n = reduce(lhs).
+//
+// Returns n.
+func (n *Number) Reduce(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberReduce(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Remainder returns the remainder of an integer division. Computes n = lhs % rhs, the remainder
+// of the division of lhs by rhs truncated to an integer (see DivideInteger()). The result has the
+// sign of lhs.
+//
+// If the integer part of the division has more than Context.Digits() digits, a NaN is returned
+// with DivisionImpossible.
+//
+// Returns n.
+func (n *Number) Remainder(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberRemainder(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// RemainderNear returns the remainder of a division as defined by IEEE 754. It is identical to
+// Remainder() except that the division is rounded to the nearest integer (using RoundHalfEven)
+// rather than truncated. The result may therefore have the opposite sign of lhs.
+//
+// If the integer part of the division has more than Context.Digits() digits, a NaN is returned
+// with DivisionImpossible.
+//
+// Returns n.
+func (n *Number) RemainderNear(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberRemainderNear(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
 
@@ -339,6 +1139,150 @@ func (n *Number) Power(lhs *Number, rhs *Number, ctx *Context) *Number {
 //
 // Returns n.
 func (n *Number) Rescale(lhs *Number, rhs *Number, ctx *Context) *Number {
-	C.decNumberRescale(n.dn, lhs.dn, rhs.dn, ctx.DecContext())
+	if n.check(ctx) {
+		C.decNumberRescale(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Rotate rotates the digits of a number. Computes n = lhs rotated by rhs digits. The coefficient
+// of lhs is padded with zeros on the left up to Context.Digits() digits, then rotated to the left
+// if rhs is positive, or to the right if rhs is negative. rhs must be an integer (with an exponent
+// of 0) in the range -Context.Digits() through +Context.Digits().
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, n is set to lhs unchanged.
+//
+// lhs is copied to n before being truncated to Context.Digits() digits, so n must have enough
+// storage space for the digits of lhs.
+//
+// Returns n.
+func (n *Number) Rotate(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.checkOperand(lhs, ctx) {
+		C.decNumberRotate(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// SameQuantum tests whether the exponents of two numbers are equal. Sets n to 1 if the exponents
+// of lhs and rhs are the same (or if both are NaN, or both are Infinite), 0 otherwise.
+//
+// The coefficients and signs of the operands are ignored. No error is possible.
+//
+// Returns n.
+func (n *Number) SameQuantum(lhs *Number, rhs *Number) *Number {
+	C.decNumberSameQuantum(n.ptr(), lhs.ptr(), rhs.ptr())
+	return n
+}
+
+// ScaleB scales a number by a power of ten. Computes n = lhs * 10 ** rhs. rhs must be an integer
+// (with an exponent of 0).
+//
+// The result may overflow or underflow. Note that the coefficient of lhs is not changed, only its
+// exponent: n must have enough storage space for the digits of lhs, even if it has more digits than
+// the precision of the Context.
+//
+// Returns n.
+func (n *Number) ScaleB(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.checkOperand(lhs, ctx) {
+		C.decNumberScaleB(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Shift shifts the digits of a number. Computes n = lhs shifted by rhs digits. The coefficient of
+// lhs is shifted to the left if rhs is positive, or to the right if rhs is negative, zeros being
+// shifted in. Digits shifted out of the Context.Digits() most significant digits are lost. rhs
+// must be an integer (with an exponent of 0) in the range -Context.Digits() through
+// +Context.Digits().
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, n is set to lhs unchanged. See
+// Rotate() for the storage space required by n.
+//
+// Returns n.
+func (n *Number) Shift(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.checkOperand(lhs, ctx) {
+		C.decNumberShift(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// SquareRoot is the square root function. Computes n = sqrt(lhs).
+//
+// The result is correctly rounded using the RoundHalfEven rounding mode, whatever the rounding mode
+// of the context. The preferred exponent of the result is floor(lhs.exponent/2).
+//
+// Returns n.
+func (n *Number) SquareRoot(lhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberSquareRoot(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Subtract subtracts a number from another. Computes n = lhs - rhs.
+//
+// Returns n.
+func (n *Number) Subtract(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberSubtract(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// ToIntegralExact rounds a number to an integer, using the rounding mode of the Context. Computes
+// n = lhs, rounded to an integral value with an exponent of 0 if the exponent of lhs is negative.
+//
+// Unlike ToIntegralValue(), Inexact and Rounded are set if the value changed, as required by IEEE
+// 754.
+//
+// The result is not rounded to the precision of the Context: n must have enough storage space for
+// the digits of lhs.
+//
+// Returns n.
+func (n *Number) ToIntegralExact(lhs *Number, ctx *Context) *Number {
+	if n.checkOperand(lhs, ctx) {
+		C.decNumberToIntegralExact(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// ToIntegralValue rounds a number to an integer, using the rounding mode of the Context. Computes
+// n = lhs, rounded to an integral value with an exponent of 0 if the exponent of lhs is negative.
+//
+// Neither Inexact nor Rounded is set, even if the operand was rounded. See ToIntegralExact() for
+// the storage space required by n.
+//
+// Returns n.
+func (n *Number) ToIntegralValue(lhs *Number, ctx *Context) *Number {
+	if n.checkOperand(lhs, ctx) {
+		C.decNumberToIntegralValue(n.ptr(), lhs.ptr(), ctx.DecContext())
+	}
+	return n
+}
+
+// Trim removes insignificant trailing zeros from a number, unconditionally. That is, if the number
+// has any fractional trailing zeros they are removed by dividing the coefficient by the appropriate
+// power of ten and adjusting the exponent accordingly. Unlike Reduce(), a number with a negative
+// or zero exponent never gets a positive exponent (100 remains 100). Zeros have their exponent set
+// to 0.
+//
+// No error is possible.
+//
+// Returns n.
+func (n *Number) Trim() *Number {
+	C.decNumberTrim(n.ptr())
+	return n
+}
+
+// Xor is the digitwise exclusive OR operator. Computes n = lhs ^ rhs.
+//
+// Logical function restrictions apply; a NaN is returned with InvalidOperation if a restriction is
+// violated.
+//
+// Returns n.
+func (n *Number) Xor(lhs *Number, rhs *Number, ctx *Context) *Number {
+	if n.check(ctx) {
+		C.decNumberXor(n.ptr(), lhs.ptr(), rhs.ptr(), ctx.DecContext())
+	}
 	return n
 }
@@ -7,6 +7,8 @@ package dec_test
 import (
 	"."
 	"./util"
+	"math"
+	"runtime"
 	"testing"
 )
 
@@ -30,6 +32,41 @@ func TestNumber_String(t *testing.T) {
 	}
 }
 
+func TestNumber_Parse(t *testing.T) {
+	ctx := dec.NewContext(dec.InitBase, 9)
+	for _, c := range []struct {
+		in, out, exact string
+		status         string
+	}{
+		{"12.34", "12.34", "12.34", "No status"},
+		{"1234567890123", "1.23456789E+12", "1234567890123", "Multiple status"},
+		{"-0E-5", "-0.00000", "-0.00000", "No status"},
+		{"sNaN1234", "sNaN1234", "sNaN1234", "No status"},
+		{"-Inf", "-Infinity", "-Infinity", "No status"},
+	} {
+		n, err := dec.ParseNumber(c.in, ctx.ZeroStatus())
+		if err != nil || n.String() != c.out || ctx.Status().String() != c.status {
+			t.Fatalf("ParseNumber(%s): expected %s (%s), got %s (%v, %v)", c.in, c.out, c.status, n, ctx.Status(), err)
+		}
+		if n.Digits() > ctx.Digits() {
+			t.Fatalf("ParseNumber(%s): got %d digits", c.in, n.Digits())
+		}
+		if n, err = dec.ParseNumberExact(c.in, ctx.ZeroStatus()); err != nil || n.String() != c.exact || ctx.ErrorStatus() != nil {
+			t.Fatalf("ParseNumberExact(%s): expected %s, got %s (%v, %v)", c.in, c.exact, n, ctx.Status(), err)
+		}
+	}
+	for _, s := range []string{"", "1.2.3", "12a", "1E", "NaN1234567890"} {
+		n, err := dec.ParseNumber(s, ctx.ZeroStatus())
+		if e, ok := err.(*dec.ContextError); !ok || !e.Test(dec.ConversionSyntax) || !n.IsQNaN() || !ctx.Status().Test(dec.ConversionSyntax) {
+			t.Fatalf("ParseNumber(%q): expected NaN with ConversionSyntax, got %s (%v, %v)", s, n, ctx.Status(), err)
+		}
+	}
+	// exponent overflow is not a syntax error
+	if n, err := dec.ParseNumber("1E+1000000000", ctx.ZeroStatus()); err != nil || !n.IsInfinite() || !ctx.Status().Test(dec.Overflow) {
+		t.Fatalf("ParseNumber: expected Infinity with Overflow, got %s (%v, %v)", n, ctx.Status(), err)
+	}
+}
+
 func TestNumber_Zero(t *testing.T) {
 	ctx := gnp.Context
 	n := gnp.Get().FromString("1.27", ctx)
@@ -43,6 +80,29 @@ func TestNumber_Zero(t *testing.T) {
 	}
 }
 
+func TestNumber_NewIsZero(t *testing.T) {
+	ctx := dec.NewContext(dec.InitDecimal64, 0)
+	x := dec.NewNumber(ctx.Digits()).FromString("1.5", ctx)
+	for _, n := range []*dec.Number{dec.NewNumber(ctx.Digits()), dec.NewNumber(1), dec.NewNumber(0)} {
+		if !n.IsZero() || n.String() != "0" {
+			t.Fatalf("Expected 0, got %s", n)
+		}
+		// usable as an operand without being set
+		if r := dec.NewNumber(ctx.Digits()).Add(x, n, ctx); r.String() != "1.5" || ctx.ErrorStatus() != nil {
+			t.Fatalf("Expected 1.5, got %s (%v)", r, ctx.Status())
+		}
+		if r := dec.NewNumber(ctx.Digits()).Compare(n, x, ctx); r.String() != "-1" {
+			t.Fatalf("Expected -1, got %s", r)
+		}
+	}
+	// storage grown by methods without a Context is zeroed as well
+	n := dec.NewNumber(1)
+	n.CopyAbs(x)
+	if s := n.String(); s != "1.5" {
+		t.Fatalf("Expected 1.5, got %s", s)
+	}
+}
+
 func TestNumber_Abs(t *testing.T) {
 	ctx := gnp.Context
 	n := gnp.Get().FromString("12.3", ctx)
@@ -216,3 +276,592 @@ func TestNumber_Divide(t *testing.T) {
 		t.Fatal(r)
 	}
 }
+
+// testUnary runs a table of tests against a single operand Number method. Each case is made of an
+// operand, the expected result and the expected status after the operation.
+func testUnary(t *testing.T, name string, ctx *dec.Context, f func(n, x *dec.Number, ctx *dec.Context) *dec.Number, cases [][3]string) {
+	n := dec.NewNumber(ctx.Digits())
+	x := dec.NewNumber(ctx.Digits())
+	for _, c := range cases {
+		x.FromString(c[0], ctx.ZeroStatus())
+		f(n, x, ctx)
+		if s := n.String(); s != c[1] {
+			t.Fatalf("%s(%s): expected %s, got %s", name, c[0], c[1], s)
+		}
+		if s := ctx.Status().String(); s != c[2] {
+			t.Fatalf("%s(%s): expected status %q, got %q", name, c[0], c[2], s)
+		}
+	}
+}
+
+func TestNumber_Exp(t *testing.T) {
+	testUnary(t, "Exp", gnp.Context, (*dec.Number).Exp, [][3]string{
+		{"0", "1", "No status"},
+		{"1", "2.718281828459045235360287471352662", "Multiple status"},
+		{"-1", "0.3678794411714423215955237701614609", "Multiple status"},
+		{"0.5", "1.648721270700128146848650787814164", "Multiple status"},
+		{"-Infinity", "0", "No status"},
+		{"Infinity", "Infinity", "No status"},
+		{"NaN", "NaN", "No status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_Ln(t *testing.T) {
+	testUnary(t, "Ln", gnp.Context, (*dec.Number).Ln, [][3]string{
+		{"1", "0", "No status"},
+		{"10", "2.302585092994045684017991454684364", "Multiple status"},
+		{"2", "0.6931471805599453094172321214581766", "Multiple status"},
+		{"0", "-Infinity", "No status"},
+		{"-1", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_Log10(t *testing.T) {
+	testUnary(t, "Log10", gnp.Context, (*dec.Number).Log10, [][3]string{
+		{"1000", "3", "No status"},
+		{"0.001", "-3", "No status"},
+		{"2", "0.3010299956639811952137388947244930", "Multiple status"},
+		{"0", "-Infinity", "No status"},
+		{"-2", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_SquareRoot(t *testing.T) {
+	testUnary(t, "SquareRoot", gnp.Context, (*dec.Number).SquareRoot, [][3]string{
+		{"100", "10", "No status"},
+		{"1.00", "1.0", "No status"},
+		{"2", "1.414213562373095048801688724209698", "Multiple status"},
+		{"0.39", "0.6244997998398398205846893120939794", "Multiple status"},
+		{"-0", "-0", "No status"},
+		{"-4", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_MaxMath(t *testing.T) {
+	// InitBase's default exponent range is too large for mathematical functions
+	ctx := dec.NewContext(dec.InitBase, 0)
+	n := dec.NewNumber(ctx.Digits())
+	x := dec.NewNumber(ctx.Digits()).FromString("2", ctx)
+	if n.Exp(x, ctx); !n.IsNaN() || !ctx.Status().Test(dec.InvalidContext) {
+		t.Fatalf("Expected NaN with InvalidContext, got %s (%v)", n, ctx.Status())
+	}
+	ctx.ZeroStatus().SetEMax(dec.MaxMath).SetEMin(-dec.MaxMath)
+	if n.Exp(x, ctx); n.String() != "7.38905610" || ctx.ErrorStatus() != nil {
+		t.Fatalf("Expected 7.38905610, got %s (%v)", n, ctx.Status())
+	}
+	// operand out of bounds
+	x.FromString("1E+1000000", ctx.ZeroStatus().SetEMax(dec.MaxEMax))
+	ctx.SetEMax(dec.MaxMath)
+	if n.Exp(x, ctx); !n.IsNaN() || !ctx.Status().Test(dec.InvalidOperation) {
+		t.Fatalf("Expected NaN with InvalidOperation, got %s (%v)", n, ctx.Status())
+	}
+}
+
+func TestNumber_Quantize(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitQuad, 0)
+		n   = dec.NewNumber(ctx.Digits())
+		x   = dec.NewNumber(ctx.Digits())
+		one = dec.NewNumber(ctx.Digits()).FromString("1", ctx)
+		in  = []string{"2.5", "-2.5", "1.51", "-0.4", "0.5", "5.01"}
+	)
+	// expected results for each rounding mode
+	out := map[dec.Rounding][]string{
+		dec.RoundCeiling:  {"3", "-2", "2", "-0", "1", "6"},
+		dec.RoundUp:       {"3", "-3", "2", "-1", "1", "6"},
+		dec.RoundHalfUp:   {"3", "-3", "2", "-0", "1", "5"},
+		dec.RoundHalfEven: {"2", "-2", "2", "-0", "0", "5"},
+		dec.RoundHalfDown: {"2", "-2", "2", "-0", "0", "5"},
+		dec.RoundDown:     {"2", "-2", "1", "-0", "0", "5"},
+		dec.RoundFloor:    {"2", "-3", "1", "-1", "0", "5"},
+		dec.Round05Up:     {"2", "-2", "1", "-1", "1", "6"},
+	}
+	for r, res := range out {
+		ctx.SetRounding(r)
+		for i, s := range in {
+			x.FromString(s, ctx.ZeroStatus())
+			if n.Quantize(x, one, ctx); n.String() != res[i] || !ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("Quantize(%s, 1) rounding %d: expected %s (Inexact), got %s (%v)", s, r, res[i], n, ctx.Status())
+			}
+			if n.ToIntegralValue(x, ctx.ZeroStatus()); n.String() != res[i] || ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("ToIntegralValue(%s) rounding %d: expected %s, got %s (%v)", s, r, res[i], n, ctx.Status())
+			}
+			if n.ToIntegralExact(x, ctx.ZeroStatus()); n.String() != res[i] || !ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("ToIntegralExact(%s) rounding %d: expected %s (Inexact), got %s (%v)", s, r, res[i], n, ctx.Status())
+			}
+		}
+	}
+	ctx.SetRounding(dec.RoundHalfEven)
+	// padding
+	x.FromString("12", ctx.ZeroStatus())
+	one.FromString("1E-2", ctx)
+	if n.Quantize(x, one, ctx); n.String() != "12.00" || ctx.ErrorStatus() != nil {
+		t.Fatalf("Expected 12.00, got %s (%v)", n, ctx.Status())
+	}
+	// coefficient overflow
+	x.FromString("123456789", ctx)
+	one.FromString("1E-30", ctx)
+	if n.Quantize(x, one, ctx); !n.IsNaN() || !ctx.Status().Test(dec.InvalidOperation) {
+		t.Fatalf("Expected NaN with InvalidOperation, got %s (%v)", n, ctx.Status())
+	}
+}
+
+func TestNumber_SameQuantum(t *testing.T) {
+	var (
+		ctx = gnp.Context
+		x   = gnp.Get().FromString("2.17", ctx)
+		y   = gnp.Get().FromString("0.01", ctx)
+		n   = gnp.Get()
+	)
+	defer gnp.Putn(x, y, n)
+	if n.SameQuantum(x, y); n.String() != "1" {
+		t.Fatalf("Expected 1, got %s", n)
+	}
+	y.FromString("0.1", ctx)
+	if n.SameQuantum(x, y); n.String() != "0" {
+		t.Fatalf("Expected 0, got %s", n)
+	}
+}
+
+func TestNumber_Reduce(t *testing.T) {
+	cases := [][3]string{
+		{"1.200", "1.2", "No status"},
+		{"120E+1", "1.2E+3", "No status"},
+		{"0.00", "0", "No status"},
+		{"-100", "-1E+2", "No status"},
+	}
+	testUnary(t, "Reduce", gnp.Context, (*dec.Number).Reduce, cases)
+	testUnary(t, "Normalize", gnp.Context, (*dec.Number).Normalize, cases)
+}
+
+func TestNumber_Trim(t *testing.T) {
+	ctx := gnp.Context
+	n := gnp.Get()
+	defer gnp.Put(n)
+	for _, c := range [][2]string{
+		{"1.200", "1.2"},
+		{"120E+1", "1.2E+3"},
+		{"-100", "-100"},
+		{"0.00", "0"},
+	} {
+		if n.FromString(c[0], ctx).Trim(); n.String() != c[1] {
+			t.Fatalf("Trim(%s): expected %s, got %s", c[0], c[1], n)
+		}
+	}
+}
+
+func TestNumber_FromInt(t *testing.T) {
+	n := gnp.Get()
+	defer gnp.Put(n)
+	for _, c := range []struct {
+		f func() *dec.Number
+		s string
+	}{
+		{func() *dec.Number { return n.FromInt32(0) }, "0"},
+		{func() *dec.Number { return n.FromInt32(-2147483648) }, "-2147483648"},
+		{func() *dec.Number { return n.FromUint32(4294967295) }, "4294967295"},
+		{func() *dec.Number { return n.FromInt64(1000) }, "1000"},
+		{func() *dec.Number { return n.FromInt64(math.MinInt64) }, "-9223372036854775808"},
+		{func() *dec.Number { return n.FromInt64(math.MaxInt64) }, "9223372036854775807"},
+		{func() *dec.Number { return n.FromUint64(0) }, "0"},
+		{func() *dec.Number { return n.FromUint64(math.MaxUint64) }, "18446744073709551615"},
+	} {
+		if s := c.f().String(); s != c.s {
+			t.Fatalf("Expected %s, got %s", c.s, s)
+		}
+	}
+}
+
+func TestNumber_ToInt(t *testing.T) {
+	ctx := gnp.Context
+	n := gnp.Get()
+	defer gnp.Put(n)
+	for _, c := range []struct {
+		s   string
+		f   func() interface{}
+		v   interface{}
+		err bool
+	}{
+		{"-2147483648", func() interface{} { return n.ToInt32(ctx) }, int32(-2147483648), false},
+		{"2147483648", func() interface{} { return n.ToInt32(ctx) }, int32(0), true},
+		{"1.0", func() interface{} { return n.ToInt32(ctx) }, int32(0), true},
+		{"4294967295", func() interface{} { return n.ToUint32(ctx) }, uint32(4294967295), false},
+		{"-1", func() interface{} { return n.ToUint32(ctx) }, uint32(0), true},
+		{"-9223372036854775808", func() interface{} { return n.ToInt64(ctx) }, int64(math.MinInt64), false},
+		{"9223372036854775807", func() interface{} { return n.ToInt64(ctx) }, int64(math.MaxInt64), false},
+		{"9223372036854775808", func() interface{} { return n.ToInt64(ctx) }, int64(0), true},
+		{"-9223372036854775809", func() interface{} { return n.ToInt64(ctx) }, int64(0), true},
+		{"1E+3", func() interface{} { return n.ToInt64(ctx) }, int64(0), true},
+		{"Infinity", func() interface{} { return n.ToInt64(ctx) }, int64(0), true},
+		{"18446744073709551615", func() interface{} { return n.ToUint64(ctx) }, uint64(math.MaxUint64), false},
+		{"18446744073709551616", func() interface{} { return n.ToUint64(ctx) }, uint64(0), true},
+		{"99999999999999999999", func() interface{} { return n.ToUint64(ctx) }, uint64(0), true},
+		{"-0", func() interface{} { return n.ToUint64(ctx) }, uint64(0), false},
+		{"-1", func() interface{} { return n.ToUint64(ctx) }, uint64(0), true},
+		{"0.5", func() interface{} { return n.ToUint64(ctx) }, uint64(0), true},
+	} {
+		n.FromString(c.s, ctx.ZeroStatus())
+		if v := c.f(); v != c.v {
+			t.Fatalf("%s: expected %v, got %v", c.s, c.v, v)
+		}
+		if err := ctx.Status().Test(dec.InvalidOperation); err != c.err {
+			t.Fatalf("%s: expected InvalidOperation to be %v", c.s, c.err)
+		}
+	}
+}
+
+// testBinary runs a table of tests against a two operands Number method. Each case is made of two
+// operands, the expected result and the expected status after the operation.
+func testBinary(t *testing.T, name string, ctx *dec.Context, f func(n, x, y *dec.Number, ctx *dec.Context) *dec.Number, cases [][4]string) {
+	n := dec.NewNumber(ctx.Digits())
+	x := dec.NewNumber(ctx.Digits())
+	y := dec.NewNumber(ctx.Digits())
+	for _, c := range cases {
+		x.FromString(c[0], ctx.ZeroStatus())
+		y.FromString(c[1], ctx)
+		f(n, x, y, ctx)
+		if s := n.String(); s != c[2] {
+			t.Fatalf("%s(%s, %s): expected %s, got %s", name, c[0], c[1], c[2], s)
+		}
+		if s := ctx.Status().String(); s != c[3] {
+			t.Fatalf("%s(%s, %s): expected status %q, got %q", name, c[0], c[1], c[3], s)
+		}
+	}
+}
+
+func TestNumber_MaxMin(t *testing.T) {
+	ctx := gnp.Context
+	testBinary(t, "Max", ctx, (*dec.Number).Max, [][4]string{
+		{"-2", "3", "3", "No status"},
+		{"1.0", "1", "1", "No status"},
+		{"-0", "0", "0", "No status"},
+		{"NaN", "1", "1", "No status"},
+		{"1", "NaN", "1", "No status"},
+		{"sNaN", "1", "NaN", "Invalid operation"},
+		{"1", "sNaN12", "NaN12", "Invalid operation"},
+	})
+	testBinary(t, "Min", ctx, (*dec.Number).Min, [][4]string{
+		{"-2", "3", "-2", "No status"},
+		{"1.0", "1", "1.0", "No status"},
+		{"NaN", "-Infinity", "-Infinity", "No status"},
+		{"sNaN", "1", "NaN", "Invalid operation"},
+	})
+	testBinary(t, "MaxMag", ctx, (*dec.Number).MaxMag, [][4]string{
+		{"-10", "3", "-10", "No status"},
+		{"NaN", "-3", "-3", "No status"},
+		{"sNaN", "1", "NaN", "Invalid operation"},
+	})
+	testBinary(t, "MinMag", ctx, (*dec.Number).MinMag, [][4]string{
+		{"-10", "3", "3", "No status"},
+		{"-1", "1", "-1", "No status"},
+		{"sNaN", "1", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_Next(t *testing.T) {
+	for _, c := range []struct {
+		kind                   dec.ContextKind
+		tiny, max, minNormal   string
+		nextOne, prevOne, prev string // next(1), prev(1), prev(minNormal)
+	}{
+		{dec.InitDecimal64, "1E-398", "9.999999999999999E+384", "1E-383",
+			"1.000000000000001", "0.9999999999999999", "9.99999999999999E-384"},
+		{dec.InitDecimal128, "1E-6176", "9.999999999999999999999999999999999E+6144", "1E-6143",
+			"1.000000000000000000000000000000001", "0.9999999999999999999999999999999999",
+			"9.99999999999999999999999999999999E-6144"},
+	} {
+		ctx := dec.NewContext(c.kind, 0)
+		testUnary(t, "NextPlus", ctx, (*dec.Number).NextPlus, [][3]string{
+			{"0", c.tiny, "No status"},
+			{"1", c.nextOne, "No status"},
+			{c.max, "Infinity", "No status"},
+			{"-Infinity", "-" + c.max, "No status"},
+			{"sNaN", "NaN", "Invalid operation"},
+		})
+		testUnary(t, "NextMinus", ctx, (*dec.Number).NextMinus, [][3]string{
+			{"0", "-" + c.tiny, "No status"},
+			{"1", c.prevOne, "No status"},
+			{c.minNormal, c.prev, "No status"},
+			{"Infinity", c.max, "No status"},
+			{"NaN", "NaN", "No status"},
+		})
+		testBinary(t, "NextToward", ctx, (*dec.Number).NextToward, [][4]string{
+			{"1", "2", c.nextOne, "No status"},
+			{"1", "-Infinity", c.prevOne, "No status"},
+			{"0", "1", c.tiny, "Multiple status"},
+			{c.minNormal, "0", c.prev, "Multiple status"},
+			{c.max, "Infinity", "Infinity", "Multiple status"},
+			{"1", "1", "1", "No status"},
+			{"-0", "0", "0", "No status"},
+			{"1", "sNaN", "NaN", "Invalid operation"},
+		})
+		// check the status set by NextToward for subnormal results
+		n := dec.NewNumber(ctx.Digits())
+		x := dec.NewNumber(ctx.Digits()).FromString(c.minNormal, ctx.ZeroStatus())
+		y := dec.NewNumber(ctx.Digits()).Zero()
+		n.NextToward(x, y, ctx)
+		if !n.IsSubnormal(ctx) || *ctx.Status() != dec.Underflow|dec.Subnormal|dec.Inexact|dec.Rounded {
+			t.Fatalf("NextToward(%s, 0): expected subnormal result and Underflow, got %s (%x)", x, n, *ctx.Status())
+		}
+	}
+}
+
+func TestNumber_DivideInteger(t *testing.T) {
+	ctx := gnp.Context
+	testBinary(t, "DivideInteger", ctx, (*dec.Number).DivideInteger, [][4]string{
+		{"10", "3", "3", "No status"},
+		{"-10", "3", "-3", "No status"},
+		{"2.4", "1", "2", "No status"},
+		{"1", "0", "Infinity", "Division by zero"},
+		{"0", "0", "NaN", "Division undefined"},
+		{"1E+40", "3", "NaN", "Division impossible"},
+	})
+	testBinary(t, "Remainder", ctx, (*dec.Number).Remainder, [][4]string{
+		{"10", "3", "1", "No status"},
+		{"-10", "3", "-1", "No status"},
+		{"2.1", "3", "2.1", "No status"},
+		{"10", "6", "4", "No status"},
+		{"1", "0", "NaN", "Invalid operation"},
+		{"1E+40", "3", "NaN", "Division impossible"},
+	})
+	testBinary(t, "RemainderNear", ctx, (*dec.Number).RemainderNear, [][4]string{
+		{"10", "3", "1", "No status"},
+		{"10", "6", "-2", "No status"},
+		{"1E+40", "3", "NaN", "Division impossible"},
+	})
+}
+
+func TestNumber_FMA(t *testing.T) {
+	var (
+		ctx = gnp.Context
+		a   = gnp.Get().FromString("1.000000000000000000000000000000001", ctx)
+		b   = gnp.Get().FromString("-1", ctx)
+		n   = gnp.Get()
+	)
+	defer gnp.Putn(a, b, n)
+	// single rounding
+	if n.FMA(a, a, b, ctx.ZeroStatus()); n.String() != "2.000000000000000000000000000000001E-33" {
+		t.Fatalf("Expected 2.000000000000000000000000000000001E-33, got %s", n)
+	}
+	// double rounding
+	if n.Multiply(a, a, ctx).Add(n, b, ctx); n.String() != "2E-33" {
+		t.Fatalf("Expected 2E-33, got %s", n)
+	}
+	b.FromString("sNaN", ctx)
+	if n.FMA(a, a, b, ctx.ZeroStatus()); !n.IsQNaN() || !ctx.Status().Test(dec.InvalidOperation) {
+		t.Fatalf("Expected NaN with InvalidOperation, got %s (%v)", n, ctx.Status())
+	}
+}
+
+func TestNumber_Logical(t *testing.T) {
+	ctx := gnp.Context
+	testBinary(t, "Or", ctx, (*dec.Number).Or, [][4]string{
+		{"101", "1110", "1111", "No status"},
+		{"0", "0", "0", "No status"},
+		{"12", "1", "NaN", "Invalid operation"},
+		{"-1", "1", "NaN", "Invalid operation"},
+	})
+	testBinary(t, "Xor", ctx, (*dec.Number).Xor, [][4]string{
+		{"101", "1110", "1011", "No status"},
+		{"1.0", "1", "NaN", "Invalid operation"},
+	})
+	testUnary(t, "Invert", gnp.Context, (*dec.Number).Invert, [][3]string{
+		{"101", "1111111111111111111111111111111010", "No status"},
+		{"0", "1111111111111111111111111111111111", "No status"},
+		{"2", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_Bits(t *testing.T) {
+	ctx := dec.NewContext(dec.InitBase, 65)
+	n := dec.NewNumber(ctx.Digits())
+	for _, c := range []struct {
+		b uint64
+		s string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{0x5, "101"},
+		{0x3c, "111100"},
+		{1 << 63, "1000000000000000000000000000000000000000000000000000000000000000"},
+		{math.MaxUint64, "1111111111111111111111111111111111111111111111111111111111111111"},
+	} {
+		if s := n.FromBits(c.b).String(); s != c.s {
+			t.Fatalf("FromBits(%x): expected %s, got %s", c.b, c.s, s)
+		}
+		if b := n.ToBits(ctx.ZeroStatus()); b != c.b || ctx.ErrorStatus() != nil {
+			t.Fatalf("ToBits(%s): expected %x, got %x (%v)", c.s, c.b, b, ctx.Status())
+		}
+	}
+	// not logical, or too long
+	for _, s := range []string{"2", "-1", "1.0", "1E+1", "NaN", "11111111111111111111111111111111111111111111111111111111111111111"} {
+		n.FromString(s, ctx)
+		if b := n.ToBits(ctx.ZeroStatus()); b != 0 || !ctx.Status().Test(dec.InvalidOperation) {
+			t.Fatalf("ToBits(%s): expected InvalidOperation, got %x (%v)", s, b, ctx.Status())
+		}
+	}
+}
+
+func TestNumber_ShiftRotate(t *testing.T) {
+	ctx := gnp.Context
+	testBinary(t, "Shift", ctx, (*dec.Number).Shift, [][4]string{
+		{"34", "8", "3400000000", "No status"},
+		{"12", "-1", "1", "No status"},
+		{"-1.5", "1", "-15.0", "No status"},
+		{"1", "35", "NaN", "Invalid operation"},
+		{"1", "1.5", "NaN", "Invalid operation"},
+	})
+	testBinary(t, "Rotate", ctx, (*dec.Number).Rotate, [][4]string{
+		{"34", "8", "3400000000", "No status"},
+		{"12345678", "-2", "7800000000000000000000000000123456", "No status"},
+		{"Infinity", "2", "Infinity", "No status"},
+		{"1", "-35", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_ScaleB(t *testing.T) {
+	ctx := gnp.Context
+	testBinary(t, "ScaleB", ctx, (*dec.Number).ScaleB, [][4]string{
+		{"7.50", "-2", "0.0750", "No status"},
+		{"1", "3", "1E+3", "No status"},
+		{"1", "1.5", "NaN", "Invalid operation"},
+		{"9E+6144", "1", "Infinity", "Multiple status"},
+	})
+	testUnary(t, "LogB", gnp.Context, (*dec.Number).LogB, [][3]string{
+		{"250", "2", "No status"},
+		{"0.03", "-2", "No status"},
+		{"-Infinity", "Infinity", "No status"},
+		{"0", "-Infinity", "Division by zero"},
+	})
+}
+
+func TestNumber_Copy(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitBase, 40)
+		x   = dec.NewNumber(ctx.Digits()).FromString("-1234567890123456789012345678901234567890", ctx)
+		y   = dec.NewNumber(ctx.Digits()).FromString("1", ctx)
+		n   = dec.NewNumber(1) // not enough storage
+	)
+	if s := n.Copy(x).String(); s != x.String() {
+		t.Fatalf("Copy: expected %s, got %s", x, s)
+	}
+	n = dec.NewNumber(1).Zero()
+	if s := n.CopyAbs(x).String(); s != "1234567890123456789012345678901234567890" {
+		t.Fatalf("CopyAbs: got %s", s)
+	}
+	n = dec.NewNumber(1).Zero()
+	if s := n.CopyNegate(x).String(); s != "1234567890123456789012345678901234567890" {
+		t.Fatalf("CopyNegate: got %s", s)
+	}
+	// n is rhs
+	n = dec.NewNumber(1).Zero()
+	if s := n.CopySign(x, n).String(); s != "1234567890123456789012345678901234567890" {
+		t.Fatalf("CopySign: got %s", s)
+	}
+	if s := n.CopySign(y, x).String(); s != "-1" {
+		t.Fatalf("CopySign: got %s", s)
+	}
+	// quiet operations
+	x.FromString("-sNaN", ctx)
+	if n.CopyAbs(x); !n.IsSNaN() || n.IsNegative() || ctx.Status().Test(dec.Errors) {
+		t.Fatalf("CopyAbs: got %s (%v)", n, ctx.Status())
+	}
+}
+
+func TestNumber_MinusPlus(t *testing.T) {
+	ctx := dec.NewContext(dec.InitBase, 5)
+	testUnary(t, "Minus", ctx, (*dec.Number).Minus, [][3]string{
+		{"1.25", "-1.25", "No status"},
+		{"-0", "0", "No status"},
+		{"-123456", "1.2346E+5", "Multiple status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	testUnary(t, "Plus", ctx, (*dec.Number).Plus, [][3]string{
+		{"-1.25", "-1.25", "No status"},
+		{"-0", "0", "No status"},
+		{"123456", "1.2346E+5", "Multiple status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+}
+
+func TestNumber_GC(t *testing.T) {
+	ctx := dec.NewContext(dec.InitDecimal128, 0)
+	nums := make([]*dec.Number, 100)
+	for i := range nums {
+		nums[i] = dec.NewNumber(ctx.Digits()).FromInt32(int32(i))
+	}
+	runtime.GC()
+	for i := range nums {
+		// allocate garbage in between
+		dec.NewNumber(ctx.Digits()).FromInt32(-1)
+		if v := nums[i].ToInt32(ctx); v != int32(i) {
+			t.Fatalf("Expected %d, got %d", i, v)
+		}
+	}
+}
+
+func BenchmarkNewNumber(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		dec.NewNumber(34)
+	}
+}
+
+func TestNumber_Exact(t *testing.T) {
+	var (
+		ctx = dec.NewContext(dec.InitDecimal64, 0)
+		big = dec.NewContext(dec.InitBase, 100)
+		x   = dec.NewNumber(big.Digits())
+		y   = dec.NewNumber(big.Digits())
+	)
+	for _, c := range []struct {
+		op   string
+		x, y string
+		res  string
+	}{
+		{"+", "1E+50", "1E-50", "100000000000000000000000000000000000000000000000000.00000000000000000000000000000000000000000000000001"},
+		{"+", "9999999999999999", "1", "10000000000000000"},
+		{"+", "0E-5", "1", "1.00000"},
+		{"+", "0E+100", "1", "1"},
+		{"+", "0", "0E+100", "0"},
+		{"+", "Infinity", "1", "Infinity"},
+		{"-", "0E+100", "1.5", "-1.5"},
+		{"-", "1", "1E-40", "0.9999999999999999999999999999999999999999"},
+		{"*", "123456789012345678901234567890", "98765432109876543210.123", "12193263113702179522511755827285982319616115378750.470"},
+		{"*", "NaN123", "1", "NaN123"},
+	} {
+		x.FromString(c.x, big)
+		y.FromString(c.y, big)
+		// use a small number as result to check that it grows
+		n := dec.NewNumber(ctx.Digits())
+		switch c.op {
+		case "+":
+			n.ExactAdd(x, y, ctx.ZeroStatus())
+		case "-":
+			n.ExactSubtract(x, y, ctx.ZeroStatus())
+		case "*":
+			n.ExactMultiply(x, y, ctx.ZeroStatus())
+		}
+		if s := n.String(); s != c.res || ctx.Status().Test(dec.Rounded|dec.Inexact|dec.Errors) {
+			t.Fatalf("%s %s %s: expected %s, got %s (%v)", c.x, c.op, c.y, c.res, s, ctx.Status())
+		}
+	}
+	// receiver is also an operand
+	x.FromString("12345678901234567890", big)
+	y.FromString("0.001", big)
+	x.ExactMultiply(x, x, ctx.ZeroStatus()).ExactAdd(x, y, ctx)
+	if s := x.String(); s != "152415787532388367501905199875019052100.001" || ctx.Status().Test(dec.Rounded|dec.Inexact) {
+		t.Fatalf("Expected 152415787532388367501905199875019052100.001, got %s (%v)", s, ctx.Status())
+	}
+	// overflow is still reported
+	x.FromString("9E+384", big)
+	if x.ExactMultiply(x, x, ctx.ZeroStatus()); !x.IsInfinite() || !ctx.Status().Test(dec.Overflow) {
+		t.Fatalf("Expected Infinity with Overflow, got %s (%v)", x, ctx.Status())
+	}
+	// the exact result does not fit in MaxDigits digits
+	x.FromString("1E+999999999", big)
+	y.FromString("1E-999999999", big)
+	if x.ExactAdd(x, y, big.ZeroStatus()); !x.IsQNaN() || *big.Status() != dec.InsufficientStorage {
+		t.Fatalf("Expected NaN with InsufficientStorage, got %s (%v)", x, big.Status())
+	}
+}
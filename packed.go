@@ -10,6 +10,7 @@ package dec
 #include "go-decnumber.h"
 #include "decNumber.h"
 #include "decPacked.h"
+#include "decQuad.h"
 */
 import "C"
 
@@ -47,17 +48,19 @@ type Packed struct {
 // and/or a scale.
 //
 // If the provided Number is nil, a new Number is created with sufficient space to hold the
-// converted number, so no error is possible unless the adjusted exponent is out of range, no sign
-// nibble was found, or a sign nibble was found before the final nibble.  In these error cases,
-// non-nil ContextError is returned and the Number will be 0.
+// converted number. If it does not have enough storage space, it is reallocated. So no error is
+// possible unless the adjusted exponent is out of range, no sign nibble was found, or a sign nibble
+// was found before the final nibble. In these error cases, non-nil ContextError is returned and the
+// Number will be 0.
 func (p *Packed) ToNumber(num *Number) (*Number, error) {
-	lp := len(p.Buf)
+	sz := int32(len(p.Buf))
+	if sz == 0 {
+		sz = 1
+	}
 	if num == nil {
-		sz := int32(lp)
-		if sz == 0 {
-			sz = 1
-		}
 		num = NewNumber(sz*2 - 1)
+	} else {
+		num.grow(sz*2 - 1)
 	}
 	if len(p.Buf) == 0 {
 		return num.Zero(), &ContextError{InvalidOperation}
@@ -91,3 +94,55 @@ func (p *Packed) FromNumber(num *Number) error {
 	}
 	return nil
 }
+
+// packedQuadBytes is the size of a decQuad in packed BCD form: one leading pad nibble, QuadDigits
+// digits and the sign nibble.
+const packedQuadBytes = (QuadDigits + 2) / 2
+
+// ToQuad converts a BCD Packed Decimal to Quad.
+//
+// The scale is used (negated) as the exponent of the Quad. If q is nil, a new Quad is created.
+//
+// A non-nil ContextError is returned if the packed decimal has more than QuadDigits digits, if the
+// exponent is out of range, or if the digit or sign nibbles are invalid. In these error cases, q is
+// left unchanged.
+func (p *Packed) ToQuad(q *Quad) (*Quad, error) {
+	if q == nil {
+		q = new(Quad)
+	}
+	var buf [packedQuadBytes]byte
+	b := p.Buf
+	for len(b) > len(buf) && b[0] == 0 {
+		b = b[1:]
+	}
+	exp := -p.Scale
+	if len(b) == 0 || len(b) > len(buf) ||
+		exp < QuadEmin-QuadDigits+1 || exp > QuadEmax-QuadDigits+1 {
+		return q, &ContextError{InvalidOperation}
+	}
+	copy(buf[len(buf)-len(b):], b)
+	res := C.decQuadFromPackedChecked((*C.decQuad)(q), C.int32_t(exp), (*C.uint8_t)(&buf[0]))
+	if res == nil {
+		return q, &ContextError{InvalidOperation}
+	}
+	return q, nil
+}
+
+// FromQuad converts a Quad to BCD Packed Decimal.
+//
+// The Quad is converted to a BCD packed decimal byte array just large enough to hold its digits and
+// the sign nibble, C (1100) for + and D (1101) for -. The Packed scale is set to the scale of the
+// Quad (its exponent, negated).
+//
+// If the Quad is a NaN or Infinity, a non-nil ContextError is returned and p is unchanged.
+func (p *Packed) FromQuad(q *Quad) error {
+	if !q.IsFinite() {
+		return &ContextError{InvalidOperation}
+	}
+	var buf [packedQuadBytes]byte
+	var exp int32
+	C.decQuadToPacked((*C.decQuad)(q), (*C.int32_t)(&exp), (*C.uint8_t)(&buf[0]))
+	p.Buf = append([]byte(nil), buf[len(buf)-int(q.Digits()+2)/2:]...)
+	p.Scale = -exp
+	return nil
+}
@@ -64,3 +64,55 @@ func TestPacked_FromNumber(t *testing.T) {
 		t.Fatalf("scale: %d, digits: %s", p.Scale, s)
 	}
 }
+
+func TestPacked_Quad(t *testing.T) {
+	var p dec.Packed
+	var q dec.Quad
+
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	for _, c := range []struct {
+		s     string
+		buf   string
+		scale int32
+	}{
+		{"3.14", "314C", 2},
+		{"-3.141", "03141D", 3},
+		{"0", "0C", 0},
+		{"1.2E+4", "012C", -3},
+		{"-1234567890123456789012345678901234", "01234567890123456789012345678901234D", 0},
+	} {
+		q.FromString(c.s, ctx)
+		if err := p.FromQuad(&q); err != nil {
+			t.Fatalf("FromQuad(%s) failed", c.s)
+		}
+		if s := bytesToHex(p.Buf); s != c.buf || p.Scale != c.scale {
+			t.Fatalf("FromQuad(%s): scale: %d, digits: %s", c.s, p.Scale, s)
+		}
+		var r dec.Quad
+		if _, err := p.ToQuad(&r); err != nil || r.String() != c.s {
+			t.Fatalf("ToQuad(%s): got %s (err: %v)", c.buf, &r, err)
+		}
+	}
+	q.FromString("Inf", ctx)
+	if err := p.FromQuad(&q); err == nil {
+		t.Fatal("FromQuad(Inf) should fail")
+	}
+
+	// leading zero bytes are ignored
+	r, err := (&dec.Packed{[]byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0x72, 0x5D}, 2}).ToQuad(nil)
+	if err != nil || r.String() != "-7.25" {
+		t.Fatalf("ToQuad: got %s (err: %v)", r, err)
+	}
+	for _, p := range []dec.Packed{
+		{nil, 0},
+		{[]byte{0x12, 0x34, 0x56, 0x78, 0x90, 0x12, 0x34, 0x56, 0x78, 0x90, 0x12, 0x34, 0x56, 0x78, 0x90, 0x12, 0x34, 0x5C}, 0},
+		{[]byte{0x1C}, 6177},
+		{[]byte{0x1C}, -6112},
+		{[]byte{0x1A, 0x2C}, 0},
+		{[]byte{0x12}, 0},
+	} {
+		if _, err := p.ToQuad(&q); err == nil || !q.IsInfinite() {
+			t.Fatalf("ToQuad(%X, %d): expected error, got %s", p.Buf, p.Scale, &q)
+		}
+	}
+}
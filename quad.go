@@ -10,10 +10,53 @@ package dec
 #include "decimal128.h"
 #include <stdlib.h>
 #include <string.h>
+
+// Mathematical functions, computed with decNumber.
+enum { QUAD_EXP, QUAD_LN, QUAD_LOG10, QUAD_POWER, QUAD_SQRT };
+
+// A decNumber with enough storage space for the coefficient of a decQuad.
+typedef struct {
+	decNumber dn;
+	decNumberUnit extra[(DECQUAD_Pmax+DECDPUN-1)/DECDPUN];
+} decQuadNumber;
+
+// decQuadMath computes the mathematical function op on lhs (and rhs for QUAD_POWER) in a decimal128
+// context using the rounding mode of set. The status is merged into set, without the Clamped, Rounded
+// and Subnormal flags that decQuad functions never set.
+static decQuad *decQuadMath(decQuad *res, const decQuad *lhs, const decQuad *rhs, decContext *set, int op) {
+	decQuadNumber l, r, n;
+	decContext wk;
+	decContextDefault(&wk, DEC_INIT_DECIMAL128);
+	wk.round = set->round;
+	decQuadToNumber(lhs, &l.dn);
+	switch (op) {
+	case QUAD_EXP:
+		decNumberExp(&n.dn, &l.dn, &wk);
+		break;
+	case QUAD_LN:
+		decNumberLn(&n.dn, &l.dn, &wk);
+		break;
+	case QUAD_LOG10:
+		decNumberLog10(&n.dn, &l.dn, &wk);
+		break;
+	case QUAD_POWER:
+		decQuadToNumber(rhs, &r.dn);
+		decNumberPower(&n.dn, &l.dn, &r.dn, &wk);
+		break;
+	case QUAD_SQRT:
+		decNumberSquareRoot(&n.dn, &l.dn, &wk);
+		break;
+	}
+	decQuadFromNumber(res, &n.dn, &wk);
+	set->status |= wk.status & ~(DEC_Clamped|DEC_Rounded|DEC_Subnormal);
+	return res;
+}
 */
 import "C"
 
 import (
+	"encoding/binary"
+	"math"
 	"unsafe"
 )
 
@@ -21,6 +64,16 @@ import (
 const (
 	QuadDigits = C.DECQUAD_Pmax
 	QuadBytes  = C.DECQUAD_Bytes
+	QuadEmax   = C.DECQUAD_Emax
+	QuadEmin   = C.DECQUAD_Emin
+)
+
+// Exponent values returned by the Exponent() and ToBCD() methods of Quad and Double for special
+// values. They can also be used with SetExponent() and FromBCD() to build special values.
+const (
+	ExponentInf  = C.DECFLOAT_Inf
+	ExponentNaN  = C.DECFLOAT_qNaN
+	ExponentSNaN = C.DECFLOAT_sNaN
 )
 
 // a decQuad represents a 128-bit decimal type in the IEEE 754 Standard for Floating Point Arithmetic.
@@ -33,11 +86,54 @@ const (
 // allocation is necessary, so Quads are much faster than using Number for arithmetic computations.
 type Quad C.decQuad
 
-// Bytes[] returns the contents of the number as a raw byte slice.
+// word returns the i-th 32 bits word of q, word 0 being the most significant one, whatever the
+// byte order.
+func (q *Quad) word(i int) uint32 {
+	if LittleEndian {
+		return binary.LittleEndian.Uint32(q[12-4*i:])
+	}
+	return binary.BigEndian.Uint32(q[4*i:])
+}
+
+// Bytes returns the contents of the number as a raw byte slice, in the native byte order (see
+// LittleEndian). Use BigEndianBytes() for the byte order of interchange data.
 func (q *Quad) Bytes() []byte {
 	return C.GoBytes(unsafe.Pointer(q), QuadBytes)
 }
 
+// BigEndianBytes returns the encoding of a Quad in big-endian (network) byte order, whatever the
+// native byte order. This is the byte order of IEEE 754 decimal128 interchange data and of DB2
+// DECFLOAT values.
+func (q *Quad) BigEndianBytes() []byte {
+	b := make([]byte, QuadBytes)
+	copyBigEndian(b, q[:])
+	return b
+}
+
+// FromBytes sets the contents of a Quad from a raw byte slice, in the same byte order as returned
+// by Bytes() (that is, the native byte order, see LittleEndian). The encoding is copied as is: use
+// IsCanonical() and Canonical() to check or fix non-canonical encodings.
+//
+// If b is not exactly QuadBytes bytes long, q is left unchanged and a non-nil ContextError is
+// returned.
+func (q *Quad) FromBytes(b []byte) (*Quad, error) {
+	if len(b) != QuadBytes {
+		return q, &ContextError{InvalidOperation}
+	}
+	copy(q[:], b)
+	return q, nil
+}
+
+// FromBigEndianBytes sets the contents of a Quad from a raw byte slice in big-endian (network)
+// byte order, as returned by BigEndianBytes(). See FromBytes().
+func (q *Quad) FromBigEndianBytes(b []byte) (*Quad, error) {
+	if len(b) != QuadBytes {
+		return q, &ContextError{InvalidOperation}
+	}
+	copyBigEndian(q[:], b)
+	return q, nil
+}
+
 // FromString converts a string to a Quad.
 //
 // The context is supplied to this routine is used for error handling
@@ -72,13 +168,15 @@ func (q *Quad) EngString() string {
 
 // ToNumber converts a Quad to a Number.
 //
-// The target number n must have appropriate space. If n is nil, a new Number will be created with
-// enough storage space.
+// If n is nil, a new Number will be created with enough storage space. If n does not have enough
+// storage space, it will be reallocated.
 //
 // No error is possible.
 func (q *Quad) ToNumber(n *Number) *Number {
 	if n == nil {
 		n = NewNumber(QuadDigits)
+	} else {
+		n.grow(QuadDigits)
 	}
 	C.decimal128ToNumber((*C.decimal128)(unsafe.Pointer(q)), n.DecNumber())
 	return n
@@ -101,6 +199,169 @@ func (q *Quad) FromNumber(source *Number, ctx *Context) *Quad {
 	return q
 }
 
+// FromInt32 converts a signed 32 bits integer to a Quad. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) FromInt32(i int32) *Quad {
+	C.decQuadFromInt32((*C.decQuad)(q), C.int32_t(i))
+	return q
+}
+
+// FromUint32 converts an unsigned 32 bits integer to a Quad. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) FromUint32(u uint32) *Quad {
+	C.decQuadFromUInt32((*C.decQuad)(q), C.uint32_t(u))
+	return q
+}
+
+// FromInt64 converts a signed 64 bits integer to a Quad. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) FromInt64(i int64) *Quad {
+	if i >= 0 {
+		return q.fromUint64(uint64(i), false)
+	}
+	// -i overflows for math.MinInt64, but its two's complement is the right magnitude
+	return q.fromUint64(-uint64(i), true)
+}
+
+// FromUint64 converts an unsigned 64 bits integer to a Quad. The exponent of the result is 0.
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) FromUint64(u uint64) *Quad {
+	return q.fromUint64(u, false)
+}
+
+func (q *Quad) fromUint64(u uint64, neg bool) *Quad {
+	var bcd [QuadDigits]byte
+	for i := len(bcd) - 1; u != 0; i-- {
+		bcd[i] = byte(u % 10)
+		u /= 10
+	}
+	C.decQuadFromBCD((*C.decQuad)(q), 0, (*C.uint8_t)(&bcd[0]), decSign(neg))
+	return q
+}
+
+// ToInt32 converts a Quad to a signed 32 bits integer, rounding it to an integral value if
+// necessary using the given rounding mode (the rounding mode of the Context is not used).
+//
+// If q is a NaN, an infinite, or if the rounded value is out of range, InvalidOperation is set in
+// the Context status and 0 is returned. Inexact is not set by rounding; see ToInt32Exact().
+func (q *Quad) ToInt32(ctx *Context, round Rounding) int32 {
+	return int32(C.decQuadToInt32((*C.decQuad)(q), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToInt32Exact is identical to ToInt32() except that Inexact is set in the Context status if the
+// value was rounded.
+func (q *Quad) ToInt32Exact(ctx *Context, round Rounding) int32 {
+	return int32(C.decQuadToInt32Exact((*C.decQuad)(q), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToUint32 converts a Quad to an unsigned 32 bits integer.
+//
+// Same as ToInt32(). Numbers that round to a negative value other than -0 are out of range.
+func (q *Quad) ToUint32(ctx *Context, round Rounding) uint32 {
+	return uint32(C.decQuadToUInt32((*C.decQuad)(q), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToUint32Exact is identical to ToUint32() except that Inexact is set in the Context status if the
+// value was rounded.
+func (q *Quad) ToUint32Exact(ctx *Context, round Rounding) uint32 {
+	return uint32(C.decQuadToUInt32Exact((*C.decQuad)(q), ctx.DecContext(), C.enum_rounding(round)))
+}
+
+// ToInt64 converts a Quad to a signed 64 bits integer.
+//
+// Same as ToInt32().
+func (q *Quad) ToInt64(ctx *Context, round Rounding) int64 {
+	return q.toInt64(ctx, round, false)
+}
+
+// ToInt64Exact is identical to ToInt64() except that Inexact is set in the Context status if the
+// value was rounded.
+func (q *Quad) ToInt64Exact(ctx *Context, round Rounding) int64 {
+	return q.toInt64(ctx, round, true)
+}
+
+// ToUint64 converts a Quad to an unsigned 64 bits integer.
+//
+// Same as ToUint32().
+func (q *Quad) ToUint64(ctx *Context, round Rounding) uint64 {
+	return q.toUint64Checked(ctx, round, false)
+}
+
+// ToUint64Exact is identical to ToUint64() except that Inexact is set in the Context status if the
+// value was rounded.
+func (q *Quad) ToUint64Exact(ctx *Context, round Rounding) uint64 {
+	return q.toUint64Checked(ctx, round, true)
+}
+
+func (q *Quad) toInt64(ctx *Context, round Rounding, exact bool) int64 {
+	if u, neg, ok := q.toUint64(ctx, round, exact); ok {
+		if !neg && u <= math.MaxInt64 {
+			return int64(u)
+		}
+		if neg && u <= -math.MinInt64 {
+			return int64(-u)
+		}
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
+func (q *Quad) toUint64Checked(ctx *Context, round Rounding, exact bool) uint64 {
+	if u, neg, ok := q.toUint64(ctx, round, exact); ok && (!neg || u == 0) {
+		return u
+	}
+	ctx.Status().Set(InvalidOperation)
+	return 0
+}
+
+// toUint64 rounds q to an integral value and returns its magnitude and sign. ok is false if q is
+// not finite or if its magnitude does not fit in an uint64.
+func (q *Quad) toUint64(ctx *Context, round Rounding, exact bool) (u uint64, neg bool, ok bool) {
+	if !q.IsFinite() {
+		return 0, false, false
+	}
+	var r Quad
+	if exact {
+		// use a copy of the Context rather than changing the rounding mode of the caller's
+		set := ctx.ctx
+		set.round = uint32(round)
+		set.status = 0
+		C.decQuadToIntegralExact((*C.decQuad)(&r), (*C.decQuad)(q), &set)
+		ctx.Status().Set(Status(set.status))
+	} else {
+		r.ToIntegralValue(q, ctx, round)
+	}
+	var bcd [QuadDigits]byte
+	var exp C.int32_t
+	neg = C.decQuadToBCD((*C.decQuad)(&r), &exp, (*C.uint8_t)(&bcd[0])) != 0
+	for _, d := range bcd {
+		if u > (math.MaxUint64-uint64(d))/10 {
+			return 0, neg, false
+		}
+		u = u*10 + uint64(d)
+	}
+	// ToIntegralValue() leaves positive exponents alone
+	for ; exp > 0 && u != 0; exp-- {
+		if u > math.MaxUint64/10 {
+			return 0, neg, false
+		}
+		u *= 10
+	}
+	return u, neg, true
+}
+
 // Canonical copies an enoding, ensuring it is canonical.
 //
 // source may be the same as q.
@@ -118,7 +379,704 @@ func (q *Quad) IsCanonical() bool {
 	return C.decQuadIsCanonical((*C.decQuad)(q)) != 0
 }
 
+//
+// Coefficient and exponent
+//
+// The coefficient of a Quad is handled as a slice of QuadDigits BCD8 digits (one decimal digit per
+// byte, most significant first). Methods taking a coefficient as input also accept shorter slices,
+// which are padded with leading zeros.
+//
+
+// Digits returns the number of significant digits in the coefficient of a Quad. If q is a NaN,
+// the number of digits in the payload is returned; if q is infinite, 1 is returned.
+func (q *Quad) Digits() int32 {
+	return int32(C.decQuadDigits((*C.decQuad)(q)))
+}
+
+// Coefficient returns the coefficient of a Quad as QuadDigits BCD8 digits, and its sign. If q is a
+// NaN, the coefficient is its payload; if q is infinite, the coefficient is all zeros.
+//
+// No error is possible.
+func (q *Quad) Coefficient() (bcd []byte, neg bool) {
+	bcd = make([]byte, QuadDigits)
+	sign := C.decQuadGetCoefficient((*C.decQuad)(q), (*C.uint8_t)(&bcd[0]))
+	return bcd, sign != 0
+}
+
+// SetCoefficient sets the coefficient and sign of a Quad, leaving its exponent or special value
+// unchanged. The most significant digit is ignored if q is a NaN, and all digits are ignored if q is
+// infinite.
+//
+// If bcd has more than QuadDigits digits or if any of them is not in the range 0-9, q is left
+// unchanged and a non-nil ContextError is returned.
+func (q *Quad) SetCoefficient(bcd []byte, neg bool) (*Quad, error) {
+	var buf [QuadDigits]byte
+	if !bcd8(buf[:], bcd) {
+		return q, &ContextError{InvalidOperation}
+	}
+	C.decQuadSetCoefficient((*C.decQuad)(q), (*C.uint8_t)(&buf[0]), decSign(neg))
+	return q, nil
+}
+
+// Exponent returns the exponent of a Quad. If q is a special value, one of ExponentInf, ExponentNaN
+// or ExponentSNaN is returned.
+//
+// No error is possible.
+func (q *Quad) Exponent() int32 {
+	return int32(C.decQuadGetExponent((*C.decQuad)(q)))
+}
+
+// SetExponent sets the exponent of a Quad, leaving its coefficient and sign unchanged. exp may also
+// be one of ExponentInf, ExponentNaN or ExponentSNaN, in which case q becomes that special value.
+//
+// Overflow or Underflow might be set in the Context if exp is out of range.
+//
+// Returns q.
+func (q *Quad) SetExponent(exp int32, ctx *Context) *Quad {
+	C.decQuadSetExponent((*C.decQuad)(q), ctx.DecContext(), C.int32_t(exp))
+	return q
+}
+
+// SameQuantum tests whether the exponents of two Quads are equal. It returns true if the exponents
+// of q and rhs are the same (or if both are NaN, or both are Infinite), false otherwise.
+//
+// The coefficients and signs of the operands are ignored. No error is possible.
+func (q *Quad) SameQuantum(rhs *Quad) bool {
+	return C.decQuadSameQuantum((*C.decQuad)(q), (*C.decQuad)(rhs)) != 0
+}
+
+// ToBCD returns the coefficient of a Quad as QuadDigits BCD8 digits, its exponent and its sign. See
+// Coefficient() and Exponent().
+//
+// No error is possible.
+func (q *Quad) ToBCD() (bcd []byte, exp int32, neg bool) {
+	bcd = make([]byte, QuadDigits)
+	sign := C.decQuadToBCD((*C.decQuad)(q), (*C.int32_t)(&exp), (*C.uint8_t)(&bcd[0]))
+	return bcd, exp, sign != 0
+}
+
+// FromBCD sets a Quad from a BCD8 coefficient, an exponent and a sign. It is the reverse of
+// ToBCD(). exp may be one of ExponentInf, ExponentNaN or ExponentSNaN in order to build a special
+// value.
+//
+// If bcd has more than QuadDigits digits, if any of them is not in the range 0-9, or if exp is out
+// of range, q is left unchanged and a non-nil ContextError is returned.
+func (q *Quad) FromBCD(bcd []byte, exp int32, neg bool) (*Quad, error) {
+	var buf [QuadDigits]byte
+	if !bcd8(buf[:], bcd) || !quadExponent(exp) {
+		return q, &ContextError{InvalidOperation}
+	}
+	C.decQuadFromBCD((*C.decQuad)(q), C.int32_t(exp), (*C.uint8_t)(&buf[0]), decSign(neg))
+	return q, nil
+}
+
+// quadExponent returns true if exp is a valid exponent for a Quad or a special value.
+func quadExponent(exp int32) bool {
+	switch exp {
+	case ExponentInf, ExponentNaN, ExponentSNaN:
+		return true
+	}
+	return exp >= QuadEmin-QuadDigits+1 && exp <= QuadEmax-QuadDigits+1
+}
+
+// bcd8 copies the BCD8 digits in src to dst, right aligned and padded with leading zeros. It
+// returns false if src does not fit in dst or has an invalid digit.
+func bcd8(dst []byte, src []byte) bool {
+	if len(src) > len(dst) {
+		return false
+	}
+	for _, d := range src {
+		if d > 9 {
+			return false
+		}
+	}
+	copy(dst[len(dst)-len(src):], src)
+	return true
+}
+
+// copyBigEndian copies src to dst, which must have the same length, converting between the
+// native and the big-endian byte orders. The conversion is its own inverse.
+func copyBigEndian(dst []byte, src []byte) {
+	if !LittleEndian {
+		copy(dst, src)
+		return
+	}
+	for i, b := range src {
+		dst[len(dst)-1-i] = b
+	}
+}
+
+// decSign converts a sign to the decFloat sign argument.
+func decSign(neg bool) C.int32_t {
+	if neg {
+		return math.MinInt32 // DECFLOAT_Sign
+	}
+	return 0
+}
+
+//
+// Comparisons and classification
+//
+// Most of the Is*() predicates have been reimplemented in Go so that they can be inlined.
+//
+
+// Class returns the Class of a Quad.
+func (q *Quad) Class() Class {
+	return Class(C.decQuadClass((*C.decQuad)(q)))
+}
+
+// Compare compares two Quads numerically. If lhs is less than rhs then q will be set to the value
+// -1. If they are equal, then q is set to 0. If lhs is greater than rhs then q will be set to the
+// value 1. If the operands are not comparable (that is, one or both is a NaN) the result will be
+// NaN.
+//
+// Returns q.
+func (q *Quad) Compare(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadCompare((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// CompareSignal compares two Quads numerically. It is identical to Compare() except that all NaNs
+// (including quiet NaNs) signal.
+//
+// Returns q.
+func (q *Quad) CompareSignal(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadCompareSignal((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// CompareTotal compares two Quads using the IEEE 754 total ordering. See Number.CompareTotal().
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) CompareTotal(lhs *Quad, rhs *Quad) *Quad {
+	C.decQuadCompareTotal((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs))
+	return q
+}
+
+// CompareTotalMag compares the magnitude of two Quads using the IEEE 754 total ordering. It is
+// identical to CompareTotal() except that the signs of the operands are ignored.
+//
+// No error is possible.
+//
+// Returns q.
+func (q *Quad) CompareTotalMag(lhs *Quad, rhs *Quad) *Quad {
+	C.decQuadCompareTotalMag((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs))
+	return q
+}
+
+// IsFinite tests whether a Quad is finite (that is, neither infinite nor a NaN).
+func (q *Quad) IsFinite() bool {
+	return q.word(0)&0x78000000 != 0x78000000
+}
+
+// IsInfinite tests whether a Quad is infinite.
+func (q *Quad) IsInfinite() bool {
+	return q.word(0)&0x7c000000 == 0x78000000
+}
+
+// IsInteger tests whether a Quad is finite and has an exponent of zero.
+func (q *Quad) IsInteger() bool {
+	w := q.word(0)
+	return w&0x63ffc000 == 0x22080000 || w&0x7bffc000 == 0x6a080000
+}
+
+// IsLogical tests whether a Quad is a valid logical operand (that is, it is finite, positive, has
+// an exponent of zero and all its digits are either 0 or 1).
+func (q *Quad) IsLogical() bool {
+	w := q.word(0)
+	return w&0xfbffc000 == 0x22080000 && w&^0xffffc912 == 0 &&
+		q.word(1)&^0x44912449 == 0 && q.word(2)&^0x12449124 == 0 && q.word(3)&^0x49124491 == 0
+}
+
+// IsNaN tests whether a Quad is a NaN (quiet or signaling).
+func (q *Quad) IsNaN() bool {
+	return q.word(0)&0x7c000000 == 0x7c000000
+}
+
+// IsNegative tests whether a Quad is negative (that is, less than zero, and not a NaN).
+//
+// Note that unlike Number.IsNegative(), this does not include minus zero or NaNs with a sign of 1.
+// See IsSigned().
+func (q *Quad) IsNegative() bool {
+	return q.IsSigned() && !q.IsZero() && !q.IsNaN()
+}
+
+// IsNormal tests whether a Quad is normal (that is, finite, non-zero, and not subnormal).
+func (q *Quad) IsNormal() bool {
+	return C.decQuadIsNormal((*C.decQuad)(q)) != 0
+}
+
+// IsPositive tests whether a Quad is positive (that is, greater than zero, and not a NaN).
+func (q *Quad) IsPositive() bool {
+	return !q.IsSigned() && !q.IsZero() && !q.IsNaN()
+}
+
+// IsSignaling tests whether a Quad is a signaling NaN.
+func (q *Quad) IsSignaling() bool {
+	return q.word(0)&0x7e000000 == 0x7e000000
+}
+
+// IsSigned tests whether a Quad has a sign of 1 (this includes minus zero and NaNs with a sign of
+// 1).
+func (q *Quad) IsSigned() bool {
+	return q.word(0)&0x80000000 != 0
+}
+
+// IsSubnormal tests whether a Quad is subnormal (that is, finite, non-zero, and with an adjusted
+// exponent less than the minimum exponent for Quads).
+func (q *Quad) IsSubnormal() bool {
+	return C.decQuadIsSubnormal((*C.decQuad)(q)) != 0
+}
+
+// IsZero tests whether a Quad is a zero (either positive or negative).
+func (q *Quad) IsZero() bool {
+	w := q.word(0)
+	return q.word(3) == 0 && q.word(2) == 0 && q.word(1) == 0 &&
+		w&0x1c003fff == 0 && w&0x60000000 != 0x60000000
+}
+
+// Zero sets the value of a Quad to zero (with an exponent of 0).
+//
+// Returns q.
+func (q *Quad) Zero() *Quad {
+	C.decQuadZero((*C.decQuad)(q))
+	return q
+}
+
+//
+// Arithmetic functions
+//
+// Unlike with Numbers, the precision of the Context is not used by Quad methods, which always
+// round their result to QuadDigits digits. The Context is only used for the rounding mode and
+// status reporting. Note that Quad methods never set the Rounded, Subnormal and Clamped status
+// flags.
+//
+
+// Abs is the absolute value operator. Computes q = abs(lhs).
+//
+// See also CopyAbs() for a quiet bitwise version of this.
+//
+// Returns q.
+func (q *Quad) Abs(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadAbs((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Add adds two Quads. Computes q = lhs + rhs.
+//
+// Returns q.
 func (q *Quad) Add(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
 	C.decQuadAdd((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
 	return q
 }
+
+// And is the digitwise AND operator. Computes q = lhs & rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns q.
+func (q *Quad) And(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadAnd((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Copy copies a Quad. Computes q = lhs.
+//
+// This is a quiet bitwise operation: no error is possible.
+//
+// Returns q.
+func (q *Quad) Copy(lhs *Quad) *Quad {
+	C.decQuadCopy((*C.decQuad)(q), (*C.decQuad)(lhs))
+	return q
+}
+
+// CopyAbs copies the absolute value of a Quad. Computes q = abs(lhs).
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs is a signaling NaN.
+//
+// Returns q.
+func (q *Quad) CopyAbs(lhs *Quad) *Quad {
+	C.decQuadCopyAbs((*C.decQuad)(q), (*C.decQuad)(lhs))
+	return q
+}
+
+// CopyNegate copies a Quad with its sign inverted. Computes q = -lhs.
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs is a signaling NaN.
+//
+// Returns q.
+func (q *Quad) CopyNegate(lhs *Quad) *Quad {
+	C.decQuadCopyNegate((*C.decQuad)(q), (*C.decQuad)(lhs))
+	return q
+}
+
+// CopySign copies a Quad with the sign of another. Computes q = lhs with the sign of rhs.
+//
+// This is a quiet bitwise operation: no error is possible, even if lhs or rhs is a signaling NaN.
+//
+// Returns q.
+func (q *Quad) CopySign(lhs *Quad, rhs *Quad) *Quad {
+	C.decQuadCopySign((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs))
+	return q
+}
+
+// Divide divides one Quad by another. Computes q = lhs / rhs.
+//
+// Returns q.
+func (q *Quad) Divide(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadDivide((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// DivideInteger divides one Quad by another and returns the integer part of the result.
+// Computes q = lhs / rhs, truncated to an integer with exponent 0.
+//
+// If the integer part of the result has more than QuadDigits digits, a NaN is returned with
+// DivisionImpossible.
+//
+// Returns q.
+func (q *Quad) DivideInteger(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadDivideInteger((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Exp is the exponential function. Computes q = e ** lhs (e raised to the power of lhs).
+//
+// There is no decQuad implementation of this function: lhs is converted to a Number, on the stack,
+// and the result is computed with Number.Exp() using the rounding mode of ctx, then rounded to
+// QuadDigits digits. It will almost always be correctly rounded, but may be up to 1 ulp in error in
+// rare cases.
+//
+// Returns q.
+func (q *Quad) Exp(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadMath((*C.decQuad)(q), (*C.decQuad)(lhs), nil, ctx.DecContext(), C.QUAD_EXP)
+	return q
+}
+
+// FMA is the fused multiply-add operator. Computes q = (lhs * rhs) + fhs.
+//
+// The multiplication is carried out first and is exact, so this operation has only the one,
+// final, rounding.
+//
+// Returns q.
+func (q *Quad) FMA(lhs *Quad, rhs *Quad, fhs *Quad, ctx *Context) *Quad {
+	C.decQuadFMA((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), (*C.decQuad)(fhs), ctx.DecContext())
+	return q
+}
+
+// Invert is the digitwise logical inversion operator. Computes q = ~lhs: each digit of lhs is
+// inverted (a 0 digit becomes 1 and vice versa), after padding lhs with zeros on the left up to
+// QuadDigits digits.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns q.
+func (q *Quad) Invert(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadInvert((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Ln is the natural logarithm function. Computes q = ln(lhs).
+//
+// Like Exp(), it is computed with Number.Ln(). A NaN is returned with InvalidOperation if lhs is
+// negative.
+//
+// Returns q.
+func (q *Quad) Ln(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadMath((*C.decQuad)(q), (*C.decQuad)(lhs), nil, ctx.DecContext(), C.QUAD_LN)
+	return q
+}
+
+// Log10 is the logarithm in base ten function. Computes q = log10(lhs).
+//
+// Like Exp(), it is computed with Number.Log10(). The result will be exact if lhs is an exact
+// power of ten.
+//
+// Returns q.
+func (q *Quad) Log10(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadMath((*C.decQuad)(q), (*C.decQuad)(lhs), nil, ctx.DecContext(), C.QUAD_LOG10)
+	return q
+}
+
+// LogB returns the adjusted exponent of a Quad, according to IEEE 754 rules. That is, the exponent
+// returned is calculated as if the decimal point followed the first significant digit (so, for
+// example, if lhs were 250 then q would be 2).
+//
+// If lhs is infinite, q is set to +Infinity. If lhs is a zero, q is set to -Infinity and
+// DivisionByZero is set. If lhs is a NaN, it is handled as usual.
+//
+// Returns q.
+func (q *Quad) LogB(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadLogB((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Max compares two Quads numerically and sets q to the larger. Computes q = max(lhs, rhs).
+//
+// If one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns q.
+func (q *Quad) Max(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMax((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// MaxMag compares the magnitude of two Quads numerically and sets q to the larger. It is
+// identical to Max() except that the signs of the operands are ignored.
+//
+// Returns q.
+func (q *Quad) MaxMag(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMaxMag((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Min compares two Quads numerically and sets q to the smaller. Computes q = min(lhs, rhs).
+//
+// If one operand is a quiet NaN and the other a number, then the number is returned. A signaling
+// NaN operand sets InvalidOperation and gives a NaN result.
+//
+// Returns q.
+func (q *Quad) Min(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMin((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// MinMag compares the magnitude of two Quads numerically and sets q to the smaller. It is
+// identical to Min() except that the signs of the operands are ignored.
+//
+// Returns q.
+func (q *Quad) MinMag(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMinMag((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Minus is the prefix minus operator. Computes q = 0 - lhs.
+//
+// See also CopyNegate() for a quiet bitwise version of this.
+//
+// Returns q.
+func (q *Quad) Minus(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadMinus((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Multiply multiplies one Quad by another. Computes q = lhs * rhs.
+//
+// Returns q.
+func (q *Quad) Multiply(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMultiply((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// NextMinus returns the next representable Quad in the direction of -Infinity. Computes q = the
+// closest value to lhs that is less than lhs.
+//
+// This is computed as though by subtracting an infinitesimal amount from lhs using RoundFloor,
+// except that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns q.
+func (q *Quad) NextMinus(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadNextMinus((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// NextPlus returns the next representable Quad in the direction of +Infinity. Computes q = the
+// closest value to lhs that is greater than lhs.
+//
+// This is computed as though by adding an infinitesimal amount to lhs using RoundCeiling, except
+// that no flags are set as long as lhs is not a signaling NaN.
+//
+// Returns q.
+func (q *Quad) NextPlus(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadNextPlus((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// NextToward returns the next representable Quad from lhs in the direction of rhs. If rhs compares
+// equal to lhs, q is set to lhs with the sign of rhs.
+//
+// This is computed as though by adding or subtracting an infinitesimal amount to lhs. Unlike
+// NextPlus() and NextMinus(), Overflow, Underflow and Inexact are set as required by IEEE 754 when
+// the result is infinite or subnormal.
+//
+// Returns q.
+func (q *Quad) NextToward(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadNextToward((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Or is the digitwise OR operator. Computes q = lhs | rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns q.
+func (q *Quad) Or(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadOr((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Plus is the prefix plus operator. Computes q = 0 + lhs.
+//
+// See also Copy() for a quiet bitwise version of this.
+//
+// Returns q.
+func (q *Quad) Plus(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadPlus((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Power raises a Quad to a power. Computes q = lhs ** rhs (lhs raised to the power of rhs).
+//
+// Like Exp(), it is computed with Number.Power(), whose restrictions apply. When rhs is an integer,
+// the result may be exact.
+//
+// Returns q.
+func (q *Quad) Power(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadMath((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext(), C.QUAD_POWER)
+	return q
+}
+
+// Quantize forces the exponent of a Quad to equal that of another. Computes q = op(lhs, rhs) where
+// op adjusts the coefficient of q (by rounding or shifting) such that the exponent of q has the
+// same value as the exponent of rhs. The numerical value of q will equal lhs, except for the
+// effects of any rounding that occurred.
+//
+// If the coefficient of q would have more than QuadDigits digits, or the exponent of rhs is out of
+// range, a NaN is returned with InvalidOperation.
+//
+// Returns q.
+func (q *Quad) Quantize(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadQuantize((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Reduce has the same effect as Plus() except that the final result is set to its simplest
+// (shortest) form without changing its value. That is, a non-zero Quad which has any trailing zeros
+// in the coefficient has those zeros removed by dividing the coefficient by the appropriate power
+// of ten and adjusting the exponent accordingly, and a zero has its exponent set to 0.
+//
+// Computes q = reduce(lhs).
+//
+// Returns q.
+func (q *Quad) Reduce(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadReduce((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// Remainder returns the remainder of an integer division. Computes q = lhs % rhs, the remainder
+// of the division of lhs by rhs truncated to an integer (see DivideInteger()). The result has the
+// sign of lhs.
+//
+// Returns q.
+func (q *Quad) Remainder(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadRemainder((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// RemainderNear returns the remainder of a division as defined by IEEE 754. It is identical to
+// Remainder() except that the division is rounded to the nearest integer (using RoundHalfEven)
+// rather than truncated.
+//
+// Returns q.
+func (q *Quad) RemainderNear(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadRemainderNear((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Rotate rotates the digits of a Quad. Computes q = lhs rotated by rhs digits. The coefficient of
+// lhs is padded with zeros on the left up to QuadDigits digits, then rotated to the left if rhs is
+// positive, or to the right if rhs is negative. rhs must be an integer (with an exponent of 0) in
+// the range -QuadDigits through +QuadDigits.
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, q is set to lhs unchanged.
+//
+// Returns q.
+func (q *Quad) Rotate(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadRotate((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// ScaleB scales a Quad by a power of ten. Computes q = lhs * 10 ** rhs. rhs must be an integer
+// (with an exponent of 0).
+//
+// The result may overflow or underflow. Note that the coefficient of lhs is not changed, only its
+// exponent.
+//
+// Returns q.
+func (q *Quad) ScaleB(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadScaleB((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// Shift shifts the digits of a Quad. Computes q = lhs shifted by rhs digits. The coefficient of
+// lhs is shifted to the left if rhs is positive, or to the right if rhs is negative, zeros being
+// shifted in. Digits shifted out of the QuadDigits most significant digits are lost. rhs must be an
+// integer (with an exponent of 0) in the range -QuadDigits through +QuadDigits.
+//
+// The sign and exponent of lhs are preserved. If lhs is infinite, q is set to lhs unchanged.
+//
+// Returns q.
+func (q *Quad) Shift(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadShift((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// SquareRoot is the square root function. Computes q = sqrt(lhs).
+//
+// It is computed with Number.SquareRoot(): the result is correctly rounded using the RoundHalfEven
+// rounding mode, whatever the rounding mode of the context.
+//
+// Returns q.
+func (q *Quad) SquareRoot(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadMath((*C.decQuad)(q), (*C.decQuad)(lhs), nil, ctx.DecContext(), C.QUAD_SQRT)
+	return q
+}
+
+// Subtract subtracts a Quad from another. Computes q = lhs - rhs.
+//
+// Returns q.
+func (q *Quad) Subtract(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadSubtract((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
+
+// ToIntegralExact rounds a Quad to an integer, using the rounding mode of the Context. Computes
+// q = lhs, rounded to an integral value with an exponent of 0 if the exponent of lhs is negative.
+//
+// Unlike ToIntegralValue(), Inexact is set if the value changed, as required by IEEE 754.
+//
+// Returns q.
+func (q *Quad) ToIntegralExact(lhs *Quad, ctx *Context) *Quad {
+	C.decQuadToIntegralExact((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext())
+	return q
+}
+
+// ToIntegralValue rounds a Quad to an integer, using the given rounding mode (the rounding mode of
+// the Context is not used). Computes q = lhs, rounded to an integral value with an exponent of 0 if
+// the exponent of lhs is negative.
+//
+// Inexact is not set, even if the operand was rounded. The Context is only used to report an
+// InvalidOperation for a signaling NaN operand.
+//
+// Returns q.
+func (q *Quad) ToIntegralValue(lhs *Quad, ctx *Context, round Rounding) *Quad {
+	C.decQuadToIntegralValue((*C.decQuad)(q), (*C.decQuad)(lhs), ctx.DecContext(), C.enum_rounding(round))
+	return q
+}
+
+// Xor is the digitwise exclusive OR operator. Computes q = lhs ^ rhs.
+//
+// Logical function restrictions apply (see IsLogical()); a NaN is returned with InvalidOperation
+// if a restriction is violated.
+//
+// Returns q.
+func (q *Quad) Xor(lhs *Quad, rhs *Quad, ctx *Context) *Quad {
+	C.decQuadXor((*C.decQuad)(q), (*C.decQuad)(lhs), (*C.decQuad)(rhs), ctx.DecContext())
+	return q
+}
@@ -6,6 +6,10 @@ package dec_test
 
 import (
 	dec "."
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
 	"testing"
 )
 
@@ -18,3 +22,591 @@ func TestQuad_EngString(t *testing.T) {
 		t.Fatalf("Expected 1.234E+9, got %s", s)
 	}
 }
+
+// floatOp computes an operation on a decFloat type (Single, Double or Quad) from the string
+// representation of its operands, and returns its result as a string.
+type floatOp func(ctx *dec.Context, operands ...string) string
+
+// testFloat runs a table of tests against a floatOp, using a new Context of the given kind. Each
+// case is made of the operands, followed by the expected result and the expected status after the
+// operation.
+func testFloat(t *testing.T, name string, kind dec.ContextKind, op floatOp, cases [][]string) {
+	ctx := dec.NewContext(kind, 0)
+	for _, c := range cases {
+		in, res, status := c[:len(c)-2], c[len(c)-2], c[len(c)-1]
+		if s := op(ctx.ZeroStatus(), in...); s != res {
+			t.Fatalf("%s(%s): expected %s, got %s", name, strings.Join(in, ", "), res, s)
+		}
+		if s := ctx.Status().String(); s != status {
+			t.Fatalf("%s(%s): expected status %q, got %q", name, strings.Join(in, ", "), status, s)
+		}
+	}
+}
+
+// methodOp returns a floatOp for a one or two operands method of a decFloat type, like
+// (*dec.Quad).Add or (*dec.Double).Add. The operands are set with the FromString method of the same
+// type.
+func methodOp(f interface{}) floatOp {
+	fn := reflect.ValueOf(f)
+	typ := fn.Type().In(0).Elem()
+	return func(ctx *dec.Context, operands ...string) string {
+		args := []reflect.Value{reflect.New(typ)}
+		for _, s := range operands {
+			x := reflect.New(typ)
+			x.MethodByName("FromString").Call([]reflect.Value{reflect.ValueOf(s), reflect.ValueOf(ctx)})
+			args = append(args, x)
+		}
+		fn.Call(append(args, reflect.ValueOf(ctx)))
+		return args[0].Interface().(fmt.Stringer).String()
+	}
+}
+
+func TestQuad_Arithmetic(t *testing.T) {
+	testFloat(t, "Add", dec.InitQuad, methodOp((*dec.Quad).Add), [][]string{
+		{"12.3", "-32.02", "-19.72", "No status"},
+		{"1E+34", "1", "1.000000000000000000000000000000000E+34", "Inexact"},
+		{"Infinity", "-Infinity", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Subtract", dec.InitQuad, methodOp((*dec.Quad).Subtract), [][]string{
+		{"1.3", "1.07", "0.23", "No status"},
+		{"1.3", "2.07", "-0.77", "No status"},
+	})
+	testFloat(t, "Multiply", dec.InitQuad, methodOp((*dec.Quad).Multiply), [][]string{
+		{"1.20", "3", "3.60", "No status"},
+		{"9E+6144", "10", "Infinity", "Multiple status"},
+	})
+	testFloat(t, "Divide", dec.InitQuad, methodOp((*dec.Quad).Divide), [][]string{
+		{"1", "4", "0.25", "No status"},
+		{"2", "3", "0.6666666666666666666666666666666667", "Inexact"},
+		{"1", "0", "Infinity", "Division by zero"},
+	})
+	testFloat(t, "DivideInteger", dec.InitQuad, methodOp((*dec.Quad).DivideInteger), [][]string{
+		{"10", "3", "3", "No status"},
+		{"-10", "3", "-3", "No status"},
+		{"1E+40", "3", "NaN", "Division impossible"},
+	})
+	testFloat(t, "Remainder", dec.InitQuad, methodOp((*dec.Quad).Remainder), [][]string{
+		{"10", "3", "1", "No status"},
+		{"-10", "3", "-1", "No status"},
+		{"1", "0", "NaN", "Invalid operation"},
+		{"1E+40", "3", "NaN", "Division impossible"},
+	})
+	testFloat(t, "RemainderNear", dec.InitQuad, methodOp((*dec.Quad).RemainderNear), [][]string{
+		{"10", "3", "1", "No status"},
+		{"10", "6", "-2", "No status"},
+	})
+	testFloat(t, "Abs", dec.InitQuad, methodOp((*dec.Quad).Abs), [][]string{
+		{"-12.3", "12.3", "No status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Minus", dec.InitQuad, methodOp((*dec.Quad).Minus), [][]string{
+		{"1.25", "-1.25", "No status"},
+		{"-0", "0", "No status"},
+	})
+	testFloat(t, "Plus", dec.InitQuad, methodOp((*dec.Quad).Plus), [][]string{
+		{"-1.25", "-1.25", "No status"},
+		{"-0", "0", "No status"},
+	})
+}
+
+func TestQuad_MaxMin(t *testing.T) {
+	testFloat(t, "Max", dec.InitQuad, methodOp((*dec.Quad).Max), [][]string{
+		{"-2", "3", "3", "No status"},
+		{"NaN", "1", "1", "No status"},
+		{"sNaN", "1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Min", dec.InitQuad, methodOp((*dec.Quad).Min), [][]string{
+		{"-2", "3", "-2", "No status"},
+		{"1.0", "1", "1.0", "No status"},
+	})
+	testFloat(t, "MaxMag", dec.InitQuad, methodOp((*dec.Quad).MaxMag), [][]string{
+		{"-10", "3", "-10", "No status"},
+	})
+	testFloat(t, "MinMag", dec.InitQuad, methodOp((*dec.Quad).MinMag), [][]string{
+		{"-10", "3", "3", "No status"},
+	})
+}
+
+func TestQuad_FMA(t *testing.T) {
+	var a, b, q dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	a.FromString("1.000000000000000000000000000000001", ctx)
+	b.FromString("-1", ctx)
+	if s := q.FMA(&a, &a, &b, ctx).String(); s != "2.000000000000000000000000000000001E-33" {
+		t.Fatalf("Expected 2.000000000000000000000000000000001E-33, got %s", s)
+	}
+}
+
+func TestQuad_Copy(t *testing.T) {
+	var x, y, q dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	x.FromString("-sNaN", ctx)
+	y.FromString("1.5", ctx)
+	if q.CopyAbs(&x); q.String() != "sNaN" || ctx.Status().Test(dec.Errors) {
+		t.Fatalf("CopyAbs: got %s (%v)", &q, ctx.Status())
+	}
+	if s := q.CopyNegate(&y).String(); s != "-1.5" {
+		t.Fatalf("CopyNegate: got %s", s)
+	}
+	if s := q.CopySign(&y, &x).String(); s != "-1.5" {
+		t.Fatalf("CopySign: got %s", s)
+	}
+	if s := q.Copy(&x).String(); s != "-sNaN" {
+		t.Fatalf("Copy: got %s", s)
+	}
+	if s := q.Zero().String(); s != "0" {
+		t.Fatalf("Zero: got %s", s)
+	}
+}
+
+func TestQuad_Compare(t *testing.T) {
+	testFloat(t, "Compare", dec.InitQuad, methodOp((*dec.Quad).Compare), [][]string{
+		{"2.1e3", "-1.24e7", "1", "No status"},
+		{"-1.24e7", "2.1e3", "-1", "No status"},
+		{"2.1e3", "2100", "0", "No status"},
+		{"2.1e3", "NaN", "NaN", "No status"},
+		{"2.1e3", "sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "CompareSignal", dec.InitQuad, methodOp((*dec.Quad).CompareSignal), [][]string{
+		{"2.1e3", "2100", "0", "No status"},
+		{"2.1e3", "NaN", "NaN", "Invalid operation"},
+	})
+	quiet := func(f func(q, x, y *dec.Quad) *dec.Quad) func(q, x, y *dec.Quad, ctx *dec.Context) *dec.Quad {
+		return func(q, x, y *dec.Quad, _ *dec.Context) *dec.Quad { return f(q, x, y) }
+	}
+	testFloat(t, "CompareTotal", dec.InitQuad, methodOp(quiet((*dec.Quad).CompareTotal)), [][]string{
+		{"2.1e3", "NaN", "-1", "No status"},
+		{"1.0", "1", "-1", "No status"},
+		{"-sNaN", "-Infinity", "-1", "No status"},
+	})
+	testFloat(t, "CompareTotalMag", dec.InitQuad, methodOp(quiet((*dec.Quad).CompareTotalMag)), [][]string{
+		{"2.1e3", "-1.24e7", "-1", "No status"},
+		{"-1", "1", "0", "No status"},
+	})
+}
+
+func TestQuad_IsXYZ(t *testing.T) {
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	predicates := map[string]func(q *dec.Quad) bool{
+		"Finite":    (*dec.Quad).IsFinite,
+		"Infinite":  (*dec.Quad).IsInfinite,
+		"Integer":   (*dec.Quad).IsInteger,
+		"Logical":   (*dec.Quad).IsLogical,
+		"NaN":       (*dec.Quad).IsNaN,
+		"Negative":  (*dec.Quad).IsNegative,
+		"Normal":    (*dec.Quad).IsNormal,
+		"Positive":  (*dec.Quad).IsPositive,
+		"Signaling": (*dec.Quad).IsSignaling,
+		"Signed":    (*dec.Quad).IsSigned,
+		"Subnormal": (*dec.Quad).IsSubnormal,
+		"Zero":      (*dec.Quad).IsZero,
+	}
+	for _, c := range []struct {
+		s     string
+		class dec.Class
+		is    string // predicates that must return true
+	}{
+		{"1234", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"101", dec.ClassPosNormal, "Finite Integer Logical Normal Positive"},
+		{"1.0", dec.ClassPosNormal, "Finite Normal Positive"},
+		{"-1E+3", dec.ClassNegNormal, "Finite Negative Normal Signed"},
+		{"-1", dec.ClassNegNormal, "Finite Integer Negative Normal Signed"},
+		{"0", dec.ClassPosZero, "Finite Integer Logical Zero"},
+		{"-0", dec.ClassNegZero, "Finite Integer Signed Zero"},
+		{"0E+10", dec.ClassPosZero, "Finite Zero"},
+		{"1E-6176", dec.ClassPosSubnormal, "Finite Positive Subnormal"},
+		{"8E+6111", dec.ClassPosNormal, "Finite Normal Positive"},
+		{"-Inf", dec.ClassNegInf, "Infinite Negative Signed"},
+		{"NaN", dec.ClassQNaN, "NaN"},
+		{"-sNaN12", dec.ClassSNaN, "NaN Signaling Signed"},
+		{"9999999999999999999999999999999999", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"1111111111111111111111111111111111", dec.ClassPosNormal, "Finite Integer Logical Normal Positive"},
+		{"1111111111111111111111111111111112", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+		{"1011111111111111111111111111111111", dec.ClassPosNormal, "Finite Integer Logical Normal Positive"},
+		{"8000000000000000000000000000000000", dec.ClassPosNormal, "Finite Integer Normal Positive"},
+	} {
+		var q dec.Quad
+		q.FromString(c.s, ctx)
+		if cl := q.Class(); cl != c.class {
+			t.Fatalf("%s: expected class %s, got %s", c.s, c.class, cl)
+		}
+		for name, f := range predicates {
+			if exp := strings.Contains(" "+c.is+" ", " "+name+" "); f(&q) != exp {
+				t.Fatalf("%s: Is%s() should be %v", c.s, name, exp)
+			}
+		}
+	}
+}
+
+func TestQuad_BCD(t *testing.T) {
+	var q dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	digits := func(bcd []byte) (s string) {
+		for _, d := range bcd {
+			s += string('0' + d)
+		}
+		return strings.TrimLeft(s, "0")
+	}
+	for _, c := range []struct {
+		s      string
+		coeff  string
+		exp    int32
+		neg    bool
+		digits int32
+	}{
+		{"-123.45", "12345", -2, true, 5},
+		{"0E+12", "", 12, false, 1},
+		{"1E-6176", "1", -6176, false, 1},
+		{"-Inf", "", dec.ExponentInf, true, 1},
+		{"NaN123", "123", dec.ExponentNaN, false, 3},
+		{"sNaN", "", dec.ExponentSNaN, false, 1},
+	} {
+		q.FromString(c.s, ctx)
+		bcd, exp, neg := q.ToBCD()
+		if len(bcd) != dec.QuadDigits || digits(bcd) != c.coeff || exp != c.exp || neg != c.neg {
+			t.Fatalf("ToBCD(%s): got %v, %d, %v", c.s, bcd, exp, neg)
+		}
+		if coeff, neg := q.Coefficient(); digits(coeff) != c.coeff || neg != c.neg || q.Exponent() != c.exp {
+			t.Fatalf("Coefficient(%s): got %v, %v", c.s, coeff, neg)
+		}
+		if d := q.Digits(); d != c.digits {
+			t.Fatalf("Digits(%s): expected %d, got %d", c.s, c.digits, d)
+		}
+		var r dec.Quad
+		if _, err := r.FromBCD(bcd, exp, neg); err != nil || r.String() != q.String() {
+			t.Fatalf("FromBCD(%s): got %s (err: %v)", c.s, &r, err)
+		}
+	}
+
+	if _, err := q.FromBCD([]byte{1, 2, 3}, 5, true); err != nil || q.String() != "-1.23E+7" {
+		t.Fatalf("FromBCD: got %s (err: %v)", &q, err)
+	}
+	if _, err := q.SetCoefficient([]byte{4, 5}, false); err != nil || q.String() != "4.5E+6" {
+		t.Fatalf("SetCoefficient: got %s (err: %v)", &q, err)
+	}
+	if s := q.SetExponent(-1, ctx).String(); s != "4.5" {
+		t.Fatalf("SetExponent: got %s", s)
+	}
+	if s := q.SetExponent(dec.ExponentInf, ctx).String(); s != "Infinity" {
+		t.Fatalf("SetExponent: got %s", s)
+	}
+	q.FromString("1", ctx)
+	if s := q.SetExponent(6200, ctx).String(); s != "Infinity" || !ctx.Status().Test(dec.Overflow) {
+		t.Fatalf("SetExponent: got %s (%v)", s, ctx.Status())
+	}
+	q.FromString("1", ctx)
+	for _, c := range []struct {
+		bcd []byte
+		exp int32
+	}{
+		{make([]byte, dec.QuadDigits+1), 0},
+		{[]byte{1, 10}, 0},
+		{[]byte{1}, 6112},
+		{[]byte{1}, -6177},
+	} {
+		if _, err := q.FromBCD(c.bcd, c.exp, false); err == nil || q.String() != "1" {
+			t.Fatalf("FromBCD(%v, %d): expected error, got %s", c.bcd, c.exp, &q)
+		}
+	}
+	if _, err := q.SetCoefficient([]byte{0xc}, false); err == nil || q.String() != "1" {
+		t.Fatalf("SetCoefficient: expected error, got %s", &q)
+	}
+}
+
+func TestQuad_FromInt(t *testing.T) {
+	var q dec.Quad
+	for _, c := range []struct {
+		f func() *dec.Quad
+		s string
+	}{
+		{func() *dec.Quad { return q.FromInt32(0) }, "0"},
+		{func() *dec.Quad { return q.FromInt32(-2147483648) }, "-2147483648"},
+		{func() *dec.Quad { return q.FromUint32(4294967295) }, "4294967295"},
+		{func() *dec.Quad { return q.FromInt64(-1000) }, "-1000"},
+		{func() *dec.Quad { return q.FromInt64(math.MinInt64) }, "-9223372036854775808"},
+		{func() *dec.Quad { return q.FromInt64(math.MaxInt64) }, "9223372036854775807"},
+		{func() *dec.Quad { return q.FromUint64(0) }, "0"},
+		{func() *dec.Quad { return q.FromUint64(math.MaxUint64) }, "18446744073709551615"},
+	} {
+		if s := c.f().String(); s != c.s {
+			t.Fatalf("Expected %s, got %s", c.s, s)
+		}
+	}
+}
+
+func TestQuad_ToInt(t *testing.T) {
+	var q dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	for _, c := range []struct {
+		s      string
+		f      func() interface{}
+		v      interface{}
+		status string
+	}{
+		{"-2147483648", func() interface{} { return q.ToInt32(ctx, dec.RoundHalfEven) }, int32(-2147483648), "No status"},
+		{"2147483648", func() interface{} { return q.ToInt32(ctx, dec.RoundHalfEven) }, int32(0), "Invalid operation"},
+		{"2.5", func() interface{} { return q.ToInt32(ctx, dec.RoundHalfEven) }, int32(2), "No status"},
+		{"2.5", func() interface{} { return q.ToInt32(ctx, dec.RoundUp) }, int32(3), "No status"},
+		{"-2.5", func() interface{} { return q.ToInt32Exact(ctx, dec.RoundFloor) }, int32(-3), "Inexact"},
+		{"1E+3", func() interface{} { return q.ToInt32(ctx, dec.RoundHalfEven) }, int32(1000), "No status"},
+		{"4294967295", func() interface{} { return q.ToUint32(ctx, dec.RoundHalfEven) }, uint32(4294967295), "No status"},
+		{"-1", func() interface{} { return q.ToUint32(ctx, dec.RoundHalfEven) }, uint32(0), "Invalid operation"},
+		{"-0.4", func() interface{} { return q.ToUint32Exact(ctx, dec.RoundHalfEven) }, uint32(0), "Inexact"},
+		{"-9223372036854775808", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(math.MinInt64), "No status"},
+		{"9223372036854775807", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(math.MaxInt64), "No status"},
+		{"9223372036854775807.5", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(0), "Invalid operation"},
+		{"-9223372036854775809", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(0), "Invalid operation"},
+		{"-9223372036854775808.5", func() interface{} { return q.ToInt64Exact(ctx, dec.RoundDown) }, int64(math.MinInt64), "Inexact"},
+		{"1.5E+3", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(1500), "No status"},
+		{"1E+6111", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(0), "Invalid operation"},
+		{"0E+6111", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(0), "No status"},
+		{"Infinity", func() interface{} { return q.ToInt64(ctx, dec.RoundHalfEven) }, int64(0), "Invalid operation"},
+		{"18446744073709551615", func() interface{} { return q.ToUint64(ctx, dec.RoundHalfEven) }, uint64(math.MaxUint64), "No status"},
+		{"18446744073709551616", func() interface{} { return q.ToUint64(ctx, dec.RoundHalfEven) }, uint64(0), "Invalid operation"},
+		{"99999999999999999999", func() interface{} { return q.ToUint64(ctx, dec.RoundHalfEven) }, uint64(0), "Invalid operation"},
+		{"-0.4", func() interface{} { return q.ToUint64(ctx, dec.RoundHalfEven) }, uint64(0), "No status"},
+		{"-1", func() interface{} { return q.ToUint64(ctx, dec.RoundHalfEven) }, uint64(0), "Invalid operation"},
+		{"0.5", func() interface{} { return q.ToUint64Exact(ctx, dec.RoundCeiling) }, uint64(1), "Inexact"},
+	} {
+		q.FromString(c.s, ctx.ZeroStatus())
+		if v := c.f(); v != c.v {
+			t.Fatalf("%s: expected %v, got %v", c.s, c.v, v)
+		}
+		if s := ctx.Status().String(); s != c.status {
+			t.Fatalf("%s: expected status %q, got %q", c.s, c.status, s)
+		}
+		if ctx.Rounding() != dec.RoundHalfEven {
+			t.Fatalf("%s: rounding mode changed", c.s)
+		}
+	}
+}
+
+func TestQuad_ToIntegral(t *testing.T) {
+	testFloat(t, "ToIntegralExact", dec.InitQuad, methodOp((*dec.Quad).ToIntegralExact), [][]string{
+		{"2.5", "2", "Inexact"},
+		{"-2.0", "-2", "No status"},
+		{"1E+3", "1E+3", "No status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	for _, r := range []struct {
+		round dec.Rounding
+		cases [][]string
+	}{
+		{dec.RoundHalfEven, [][]string{{"2.5", "2", "No status"}, {"-7.5", "-8", "No status"}}},
+		{dec.RoundUp, [][]string{{"2.1", "3", "No status"}, {"-7.1", "-8", "No status"}}},
+		{dec.RoundFloor, [][]string{{"2.9", "2", "No status"}, {"-7.1", "-8", "No status"}}},
+		{dec.RoundDown, [][]string{{"1.00", "1", "No status"}, {"sNaN", "NaN", "Invalid operation"}}},
+	} {
+		round := r.round
+		testFloat(t, "ToIntegralValue", dec.InitQuad, methodOp(func(q, x *dec.Quad, ctx *dec.Context) *dec.Quad {
+			return q.ToIntegralValue(x, ctx, round)
+		}), r.cases)
+	}
+}
+
+func TestQuad_Quantize(t *testing.T) {
+	var q, x, one dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	in := []string{"2.5", "-2.5", "1.51", "-0.4", "0.5", "5.01"}
+	one.FromString("1", ctx)
+	// expected results for each rounding mode
+	out := map[dec.Rounding][]string{
+		dec.RoundCeiling:  {"3", "-2", "2", "-0", "1", "6"},
+		dec.RoundUp:       {"3", "-3", "2", "-1", "1", "6"},
+		dec.RoundHalfUp:   {"3", "-3", "2", "-0", "1", "5"},
+		dec.RoundHalfEven: {"2", "-2", "2", "-0", "0", "5"},
+		dec.RoundHalfDown: {"2", "-2", "2", "-0", "0", "5"},
+		dec.RoundDown:     {"2", "-2", "1", "-0", "0", "5"},
+		dec.RoundFloor:    {"2", "-3", "1", "-1", "0", "5"},
+		dec.Round05Up:     {"2", "-2", "1", "-1", "1", "6"},
+	}
+	for r, res := range out {
+		ctx.SetRounding(r)
+		for i, s := range in {
+			x.FromString(s, ctx.ZeroStatus())
+			if q.Quantize(&x, &one, ctx); q.String() != res[i] || !ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("Quantize(%s, 1) rounding %d: expected %s (Inexact), got %s (%v)", s, r, res[i], &q, ctx.Status())
+			}
+			if q.ToIntegralValue(&x, ctx.ZeroStatus(), r); q.String() != res[i] || ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("ToIntegralValue(%s) rounding %d: expected %s, got %s (%v)", s, r, res[i], &q, ctx.Status())
+			}
+			if q.ToIntegralExact(&x, ctx.ZeroStatus()); q.String() != res[i] || !ctx.Status().Test(dec.Inexact) {
+				t.Fatalf("ToIntegralExact(%s) rounding %d: expected %s (Inexact), got %s (%v)", s, r, res[i], &q, ctx.Status())
+			}
+		}
+	}
+	testFloat(t, "Quantize", dec.InitQuad, methodOp((*dec.Quad).Quantize), [][]string{
+		{"12", "1E-2", "12.00", "No status"},               // padding
+		{"123456789", "1E-30", "NaN", "Invalid operation"}, // coefficient overflow
+		{"1.2345", "0.01", "1.23", "Inexact"},              // currency template
+		{"Infinity", "1", "NaN", "Invalid operation"},
+	})
+}
+
+func TestQuad_SameQuantum(t *testing.T) {
+	var x, y dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	x.FromString("2.17", ctx)
+	if !x.SameQuantum(y.FromString("0.01", ctx)) {
+		t.Fatal("Expected true")
+	}
+	if x.SameQuantum(y.FromString("0.1", ctx)) {
+		t.Fatal("Expected false")
+	}
+	if !x.FromString("NaN", ctx).SameQuantum(y.FromString("sNaN", ctx)) {
+		t.Fatal("Expected true for NaNs")
+	}
+}
+
+func TestQuad_Reduce(t *testing.T) {
+	testFloat(t, "Reduce", dec.InitQuad, methodOp((*dec.Quad).Reduce), [][]string{
+		{"1.200", "1.2", "No status"},
+		{"120E+1", "1.2E+3", "No status"},
+		{"0.00", "0", "No status"},
+		{"-100", "-1E+2", "No status"},
+	})
+}
+
+func TestQuad_Next(t *testing.T) {
+	const (
+		tiny      = "1E-6176"
+		max       = "9.999999999999999999999999999999999E+6144"
+		minNormal = "1E-6143"
+		nextOne   = "1.000000000000000000000000000000001"
+		prevOne   = "0.9999999999999999999999999999999999"
+		prev      = "9.99999999999999999999999999999999E-6144"
+	)
+	testFloat(t, "NextPlus", dec.InitQuad, methodOp((*dec.Quad).NextPlus), [][]string{
+		{"0", tiny, "No status"},
+		{"1", nextOne, "No status"},
+		{max, "Infinity", "No status"},
+		{"-Infinity", "-" + max, "No status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "NextMinus", dec.InitQuad, methodOp((*dec.Quad).NextMinus), [][]string{
+		{"0", "-" + tiny, "No status"},
+		{"1", prevOne, "No status"},
+		{minNormal, prev, "No status"},
+		{"Infinity", max, "No status"},
+		{"NaN", "NaN", "No status"},
+	})
+	testFloat(t, "NextToward", dec.InitQuad, methodOp((*dec.Quad).NextToward), [][]string{
+		{"1", "2", nextOne, "No status"},
+		{"1", "-Infinity", prevOne, "No status"},
+		{"0", "1", tiny, "Multiple status"},
+		{minNormal, "0", prev, "Multiple status"},
+		{max, "Infinity", "Infinity", "Multiple status"},
+		{"1", "1", "1", "No status"},
+		{"-0", "0", "0", "No status"},
+		{"1", "sNaN", "NaN", "Invalid operation"},
+	})
+}
+
+func TestQuad_ScaleB(t *testing.T) {
+	testFloat(t, "ScaleB", dec.InitQuad, methodOp((*dec.Quad).ScaleB), [][]string{
+		{"7.50", "-2", "0.0750", "No status"},
+		{"1", "3", "1E+3", "No status"},
+		{"1", "1.5", "NaN", "Invalid operation"},
+		{"9E+6144", "1", "Infinity", "Multiple status"},
+	})
+	testFloat(t, "LogB", dec.InitQuad, methodOp((*dec.Quad).LogB), [][]string{
+		{"250", "2", "No status"},
+		{"0.03", "-2", "No status"},
+		{"-Infinity", "Infinity", "No status"},
+		{"0", "-Infinity", "Division by zero"},
+	})
+}
+
+func TestQuad_Logical(t *testing.T) {
+	testFloat(t, "And", dec.InitQuad, methodOp((*dec.Quad).And), [][]string{
+		{"1101", "111", "101", "No status"},
+		{"1.1", "1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Or", dec.InitQuad, methodOp((*dec.Quad).Or), [][]string{
+		{"101", "1110", "1111", "No status"},
+		{"0", "0", "0", "No status"},
+		{"12", "1", "NaN", "Invalid operation"},
+		{"-1", "1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Xor", dec.InitQuad, methodOp((*dec.Quad).Xor), [][]string{
+		{"101", "1110", "1011", "No status"},
+		{"1.0", "1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Invert", dec.InitQuad, methodOp((*dec.Quad).Invert), [][]string{
+		{"101", "1111111111111111111111111111111010", "No status"},
+		{"0", "1111111111111111111111111111111111", "No status"},
+		{"2", "NaN", "Invalid operation"},
+	})
+}
+
+func TestQuad_ShiftRotate(t *testing.T) {
+	testFloat(t, "Shift", dec.InitQuad, methodOp((*dec.Quad).Shift), [][]string{
+		{"34", "8", "3400000000", "No status"},
+		{"12", "-1", "1", "No status"},
+		{"-1.5", "1", "-15.0", "No status"},
+		{"1", "35", "NaN", "Invalid operation"},
+		{"1", "1.5", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Rotate", dec.InitQuad, methodOp((*dec.Quad).Rotate), [][]string{
+		{"34", "8", "3400000000", "No status"},
+		{"12345678", "-2", "7800000000000000000000000000123456", "No status"},
+		{"Infinity", "2", "Infinity", "No status"},
+		{"1", "-35", "NaN", "Invalid operation"},
+	})
+}
+
+func TestQuad_Math(t *testing.T) {
+	testFloat(t, "Exp", dec.InitQuad, methodOp((*dec.Quad).Exp), [][]string{
+		{"0", "1", "No status"},
+		{"1", "2.718281828459045235360287471352662", "Inexact"},
+		{"-1", "0.3678794411714423215955237701614609", "Inexact"},
+		{"-Infinity", "0", "No status"},
+		{"1E+5", "Infinity", "Multiple status"},
+		{"sNaN", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Ln", dec.InitQuad, methodOp((*dec.Quad).Ln), [][]string{
+		{"1", "0", "No status"},
+		{"10", "2.302585092994045684017991454684364", "Inexact"},
+		{"0", "-Infinity", "No status"},
+		{"-1", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Log10", dec.InitQuad, methodOp((*dec.Quad).Log10), [][]string{
+		{"1000", "3", "No status"},
+		{"2", "0.3010299956639811952137388947244930", "Inexact"},
+		{"-2", "NaN", "Invalid operation"},
+	})
+	testFloat(t, "Power", dec.InitQuad, methodOp((*dec.Quad).Power), [][]string{
+		{"2", "10", "1024", "No status"},
+		{"2", "0.5", "1.414213562373095048801688724209698", "Inexact"},
+		{"10", "-2", "0.01", "No status"},
+		{"0", "-1", "Infinity", "No status"},
+		{"-2", "0.5", "NaN", "Invalid operation"},
+		{"10", "6145", "Infinity", "Multiple status"},
+	})
+	testFloat(t, "SquareRoot", dec.InitQuad, methodOp((*dec.Quad).SquareRoot), [][]string{
+		{"100", "10", "No status"},
+		{"1.00", "1.0", "No status"},
+		{"2", "1.414213562373095048801688724209698", "Inexact"},
+		{"-0", "-0", "No status"},
+		{"-4", "NaN", "Invalid operation"},
+	})
+	// the rounding mode of the Context is used
+	var q, x dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0).SetRounding(dec.RoundDown)
+	if s := q.Exp(x.FromString("1", ctx), ctx).String(); s != "2.718281828459045235360287471352662" {
+		t.Fatalf("Exp(1) RoundDown: got %s", s)
+	}
+	if s := q.Exp(x.FromString("-1", ctx), ctx).String(); s != "0.3678794411714423215955237701614608" {
+		t.Fatalf("Exp(-1) RoundDown: got %s", s)
+	}
+}
+
+func BenchmarkQuad_Exp(b *testing.B) {
+	var q, x dec.Quad
+	ctx := dec.NewContext(dec.InitQuad, 0)
+	x.FromString("1.5", ctx)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		q.Exp(&x, ctx)
+	}
+}
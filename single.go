@@ -0,0 +1,191 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec
+
+/*
+#include "go-decnumber.h"
+#include "decSingle.h"
+#include "decimal32.h"
+#include <stdlib.h>
+#include <string.h>
+*/
+import "C"
+
+import "unsafe"
+
+// Single characteristics.
+const (
+	SingleDigits = C.DECSINGLE_Pmax
+	SingleBytes  = C.DECSINGLE_Bytes
+	SingleEmax   = C.DECSINGLE_Emax
+	SingleEmin   = C.DECSINGLE_Emin
+)
+
+// A Single represents a 32-bit decimal type in the IEEE 754 Standard for Floating Point Arithmetic,
+// with SingleDigits digits of precision.
+//
+// The Single and Decimal32 structures are identical (except in name). Thus, Decimal32 specific
+// functions have been merged in Single's method set.
+//
+// Single is a storage format only: there are no arithmetic functions. To compute with Singles,
+// widen them to Double or Quad first, or convert them to Number.
+type Single C.decSingle
+
+// Bytes returns the contents of the number as a raw byte slice, in the native byte order (see
+// LittleEndian). Use BigEndianBytes() for the byte order of interchange data.
+func (s *Single) Bytes() []byte {
+	return C.GoBytes(unsafe.Pointer(s), SingleBytes)
+}
+
+// BigEndianBytes returns the encoding of a Single in big-endian (network) byte order, whatever the
+// native byte order. This is the byte order of IEEE 754 decimal32 interchange data and of DB2
+// DECFLOAT values.
+func (s *Single) BigEndianBytes() []byte {
+	b := make([]byte, SingleBytes)
+	copyBigEndian(b, s[:])
+	return b
+}
+
+// FromBytes sets the contents of a Single from a raw byte slice, in the same byte order as returned
+// by Bytes() (that is, the native byte order, see LittleEndian). The encoding is copied as is: use
+// IsCanonical() and Canonical() to check or fix non-canonical encodings.
+//
+// If b is not exactly SingleBytes bytes long, s is left unchanged and a non-nil ContextError is
+// returned.
+func (s *Single) FromBytes(b []byte) (*Single, error) {
+	if len(b) != SingleBytes {
+		return s, &ContextError{InvalidOperation}
+	}
+	copy(s[:], b)
+	return s, nil
+}
+
+// FromBigEndianBytes sets the contents of a Single from a raw byte slice in big-endian (network)
+// byte order, as returned by BigEndianBytes(). See FromBytes().
+func (s *Single) FromBigEndianBytes(b []byte) (*Single, error) {
+	if len(b) != SingleBytes {
+		return s, &ContextError{InvalidOperation}
+	}
+	copyBigEndian(s[:], b)
+	return s, nil
+}
+
+// FromString converts a string to a Single.
+//
+// The context is supplied to this routine is used for error handling
+// (setting of status and traps) and for the rounding mode, only.
+// If an error occurs, the result will be a valid Single NaN.
+func (s *Single) FromString(str string, ctx *Context) *Single {
+	cs := C.CString(str)
+	defer C.free(unsafe.Pointer(cs))
+	C.decSingleFromString((*C.decSingle)(s), cs, ctx.DecContext())
+	return s
+}
+
+// String converts a Single to a string.
+//
+// No error is possible, and no status can be set.
+func (s *Single) String() string {
+	str := make([]byte, C.DECSINGLE_String) // TODO: see sync.Pool and fmt
+	pStr := (*C.char)(unsafe.Pointer(&str[0]))
+	C.decSingleToString((*C.decSingle)(s), pStr)
+	return string(str[:C.strlen(pStr)])
+}
+
+// EngString converts a Single to a string in engineering format.
+//
+// No error is possible, and no status can be set.
+func (s *Single) EngString() string {
+	str := make([]byte, C.DECSINGLE_String) // TODO: see sync.Pool and fmt
+	pStr := (*C.char)(unsafe.Pointer(&str[0]))
+	C.decSingleToEngString((*C.decSingle)(s), pStr)
+	return string(str[:C.strlen(pStr)])
+}
+
+// ToNumber converts a Single to a Number.
+//
+// If n is nil, a new Number will be created with enough storage space. If n does not have enough
+// storage space, it will be reallocated.
+//
+// No error is possible.
+func (s *Single) ToNumber(n *Number) *Number {
+	if n == nil {
+		n = NewNumber(SingleDigits)
+	} else {
+		n.grow(SingleDigits)
+	}
+	C.decimal32ToNumber((*C.decimal32)(unsafe.Pointer(s)), n.DecNumber())
+	return n
+}
+
+// FromNumber converts a Number to a Single.
+//
+// The Context is used only for status reporting and for the rounding mode (used if the coefficient
+// is more than SingleDigits digits or an overflow is detected). If the exponent is out of the
+// valid range then Overflow or Underflow will be raised.  After Underflow a subnormal result is
+// possible.
+//
+// Clamped is set if the number has to be 'folded down' to fit, by reducing its exponent and
+// multiplying the coefficient by a power of ten, or if the exponent on a zero had to be
+// clamped.
+//
+// returns s.
+func (s *Single) FromNumber(source *Number, ctx *Context) *Single {
+	C.decimal32FromNumber((*C.decimal32)(unsafe.Pointer(s)), source.DecNumber(), ctx.DecContext())
+	return s
+}
+
+// ToDouble widens a Single to a Double. Computes d = s.
+//
+// If d is nil, a new Double is created. No error is possible, and the result is canonical.
+//
+// Returns d.
+func (s *Single) ToDouble(d *Double) *Double {
+	if d == nil {
+		d = new(Double)
+	}
+	C.decSingleToWider((*C.decSingle)(s), (*C.decDouble)(d))
+	return d
+}
+
+// ToQuad widens a Single to a Quad. Computes q = s.
+//
+// If q is nil, a new Quad is created. No error is possible, and the result is canonical.
+//
+// Returns q.
+func (s *Single) ToQuad(q *Quad) *Quad {
+	var d Double
+	if q == nil {
+		q = new(Quad)
+	}
+	C.decSingleToWider((*C.decSingle)(s), (*C.decDouble)(&d))
+	C.decDoubleToWider((*C.decDouble)(&d), (*C.decQuad)(q))
+	return q
+}
+
+// Canonical copies an enoding, ensuring it is canonical.
+//
+// source may be the same as s.
+//
+// Returns s.
+//
+// No error is possible.
+func (s *Single) Canonical(source *Single) *Single {
+	C.decimal32Canonical((*C.decimal32)(unsafe.Pointer(s)), (*C.decimal32)(unsafe.Pointer(source)))
+	return s
+}
+
+// IsCanonical tests wether encoding is canonical.
+func (s *Single) IsCanonical() bool {
+	return C.decimal32IsCanonical((*C.decimal32)(unsafe.Pointer(s))) != 0
+}
+
+// Zero sets the value of a Single to zero (with an exponent of 0).
+//
+// Returns s.
+func (s *Single) Zero() *Single {
+	C.decSingleZero((*C.decSingle)(s))
+	return s
+}
@@ -0,0 +1,70 @@
+// Copyright 2014 Denis Bernard. All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package dec_test
+
+import (
+	dec "."
+	"testing"
+)
+
+func TestSingle_String(t *testing.T) {
+	var s dec.Single
+	ctx := dec.NewContext(dec.InitSingle, 0)
+	for _, c := range []struct {
+		in, out, eng, status string
+	}{
+		{"12.99", "12.99", "12.99", "No status"},
+		{"-1234567.8", "-1234568", "-1234568", "Inexact"},
+		{"123.4e7", "1.234E+9", "1.234E+9", "No status"},
+		{"1E+96", "1.000000E+96", "1.000000E+96", "No status"},
+		{"1E+100", "Infinity", "Infinity", "Multiple status"},
+		{"-sNaN", "-sNaN", "-sNaN", "No status"},
+		{"abc", "NaN", "NaN", "Conversion syntax"},
+	} {
+		s.FromString(c.in, ctx.ZeroStatus())
+		if str := s.String(); str != c.out {
+			t.Fatalf("%s: expected %s, got %s", c.in, c.out, str)
+		}
+		if str := s.EngString(); str != c.eng {
+			t.Fatalf("%s: expected %s, got %s", c.in, c.eng, str)
+		}
+		if st := ctx.Status().String(); st != c.status {
+			t.Fatalf("%s: expected status %q, got %q", c.in, c.status, st)
+		}
+	}
+	if b := s.Zero().Bytes(); len(b) != dec.SingleBytes || s.String() != "0" {
+		t.Fatalf("Zero: got %s (%x)", &s, b)
+	}
+}
+
+func TestSingle_Number(t *testing.T) {
+	var s dec.Single
+	ctx := dec.NewContext(dec.InitSingle, 0)
+	n := dec.NewNumber(20).FromString("3.14159265", dec.NewContext(dec.InitBase, 20))
+	if str := s.FromNumber(n, ctx).String(); str != "3.141593" || !ctx.Status().Test(dec.Inexact) {
+		t.Fatalf("FromNumber: got %s (%v)", str, ctx.Status())
+	}
+	if str := s.ToNumber(nil).String(); str != "3.141593" {
+		t.Fatalf("ToNumber: got %s", str)
+	}
+	if str := s.ToNumber(dec.NewNumber(1)).String(); str != "3.141593" {
+		t.Fatalf("ToNumber: got %s", str)
+	}
+}
+
+func TestSingle_Widen(t *testing.T) {
+	var s dec.Single
+	var d dec.Double
+	ctx := dec.NewContext(dec.InitSingle, 0)
+	for _, c := range []string{"12.99", "-1.000000E-95", "1E-101", "9.999999E+96", "-Infinity", "NaN123", "-sNaN"} {
+		s.FromString(c, ctx)
+		if str := s.ToDouble(&d).String(); str != c || !d.IsCanonical() {
+			t.Fatalf("ToDouble(%s): got %s", c, str)
+		}
+		if str := s.ToQuad(nil).String(); str != c {
+			t.Fatalf("ToQuad(%s): got %s", c, str)
+		}
+	}
+}